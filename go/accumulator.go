@@ -0,0 +1,56 @@
+package cement
+
+import "errors"
+
+// Accumulator incrementally builds a Result of a slice from a stream of
+// Results. It is the stateful counterpart of Collect and CollectAll and is
+// not safe for concurrent use.
+type Accumulator[T any] struct {
+	out          []T
+	errs         []error
+	allErrors    bool
+	keepAfterErr bool
+}
+
+// NewAccumulator returns an empty Accumulator. With allErrors it records
+// every error and Result joins them like CollectAll; otherwise only the
+// first error is kept. With keepAfterErr Ok values pushed after an error
+// are still appended to Values; otherwise they are dropped.
+func NewAccumulator[T any](allErrors, keepAfterErr bool) *Accumulator[T] {
+	return &Accumulator[T]{allErrors: allErrors, keepAfterErr: keepAfterErr}
+}
+
+// Push records r.
+func (a *Accumulator[T]) Push(r Result[T]) {
+	if r.IsErr() {
+		if a.allErrors || len(a.errs) == 0 {
+			a.errs = append(a.errs, r.Err())
+		}
+		return
+	}
+	if len(a.errs) > 0 && !a.keepAfterErr {
+		return
+	}
+	a.out = append(a.out, r.Ok())
+}
+
+// Values returns the Ok values collected so far.
+func (a *Accumulator[T]) Values() []T {
+	return a.out
+}
+
+// Result finalizes the Accumulator: Ok with every collected value, or Err
+// with the recorded error(s). A single error is passed through as is.
+func (a *Accumulator[T]) Result() Result[[]T] {
+	switch len(a.errs) {
+	case 0:
+		if a.out == nil {
+			return Ok([]T{})
+		}
+		return Ok(a.out)
+	case 1:
+		return Err[[]T](a.errs[0])
+	default:
+		return Err[[]T](errors.Join(a.errs...))
+	}
+}
@@ -0,0 +1,50 @@
+package cement
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestAccumulatorAllOk(t *testing.T) {
+	acc := NewAccumulator[int](false, false)
+	assert.DeepEqual(t, acc.Result().Ok(), []int{})
+	for i := range 3 {
+		acc.Push(Ok(i))
+	}
+	assert.DeepEqual(t, acc.Result().Ok(), []int{0, 1, 2})
+}
+
+func TestAccumulatorFirstError(t *testing.T) {
+	e1, e2 := errors.New("e1"), errors.New("e2")
+	acc := NewAccumulator[int](false, false)
+	acc.Push(Ok(1))
+	acc.Push(Err[int](e1))
+	acc.Push(Ok(2))
+	acc.Push(Err[int](e2))
+	assert.Equal(t, acc.Result().Err(), e1)
+	assert.DeepEqual(t, acc.Values(), []int{1})
+}
+
+func TestAccumulatorKeepAfterErr(t *testing.T) {
+	e1 := errors.New("e1")
+	acc := NewAccumulator[int](false, true)
+	acc.Push(Ok(1))
+	acc.Push(Err[int](e1))
+	acc.Push(Ok(2))
+	assert.Equal(t, acc.Result().Err(), e1)
+	assert.DeepEqual(t, acc.Values(), []int{1, 2})
+}
+
+func TestAccumulatorAllErrors(t *testing.T) {
+	e1, e2 := errors.New("e1"), errors.New("e2")
+	acc := NewAccumulator[int](true, false)
+	acc.Push(Err[int](e1))
+	acc.Push(Ok(1))
+	acc.Push(Err[int](e2))
+	err := acc.Result().Err()
+	assert.Assert(t, errors.Is(err, e1))
+	assert.Assert(t, errors.Is(err, e2))
+	assert.Equal(t, len(acc.Values()), 0)
+}
@@ -0,0 +1,26 @@
+// Package assert provides test helpers for cement Results.
+package assert
+
+import (
+	"testing"
+
+	cement "github.com/mabels/cement/go"
+)
+
+// AssertOk fails the test if r is an Err and returns the Ok value.
+func AssertOk[T any](t testing.TB, r cement.Result[T]) T {
+	t.Helper()
+	if r.IsErr() {
+		t.Fatalf("expected Ok, got Err: %v", r.Err())
+	}
+	return r.Ok()
+}
+
+// AssertErr fails the test if r is Ok and returns the error.
+func AssertErr[T any](t testing.TB, r cement.Result[T]) error {
+	t.Helper()
+	if r.IsOk() {
+		t.Fatalf("expected Err, got Ok: %v", r.Ok())
+	}
+	return r.Err()
+}
@@ -0,0 +1,52 @@
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	cement "github.com/mabels/cement/go"
+	"gotest.tools/v3/assert"
+)
+
+type fakeT struct {
+	testing.TB
+	helper bool
+	failed string
+}
+
+func (f *fakeT) Helper() {
+	f.helper = true
+}
+
+func (f *fakeT) Fatalf(format string, args ...any) {
+	f.failed = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
+
+func run(f func(*fakeT)) *fakeT {
+	ft := &fakeT{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f(ft)
+	}()
+	<-done
+	return ft
+}
+
+func TestAssertOk(t *testing.T) {
+	assert.Equal(t, AssertOk(t, cement.Ok(1)), 1)
+
+	ft := run(func(ft *fakeT) { AssertOk(ft, cement.Err[int]("boom")) })
+	assert.Equal(t, ft.helper, true)
+	assert.Equal(t, ft.failed, "expected Ok, got Err: boom")
+}
+
+func TestAssertErr(t *testing.T) {
+	assert.Error(t, AssertErr(t, cement.Err[int]("boom")), "boom")
+
+	ft := run(func(ft *fakeT) { AssertErr(ft, cement.Ok(1)) })
+	assert.Equal(t, ft.helper, true)
+	assert.Equal(t, ft.failed, "expected Err, got Ok: 1")
+}
@@ -0,0 +1,72 @@
+package cement
+
+import "errors"
+
+// CollectChan drains ch until it is closed and returns Ok with all values
+// or the first error received. The order of values is the receive order,
+// which is not deterministic for concurrent senders. ch is drained to the
+// end even after an error so senders never block.
+func CollectChan[T any](ch <-chan Result[T]) Result[[]T] {
+	out := make([]T, 0)
+	var first error
+	for r := range ch {
+		if first != nil {
+			continue
+		}
+		if r.IsErr() {
+			first = r.Err()
+			continue
+		}
+		out = append(out, r.Ok())
+	}
+	if first != nil {
+		return Err[[]T](first)
+	}
+	return Ok(out)
+}
+
+// CollectChanAll is like CollectChan but joins every error received.
+func CollectChanAll[T any](ch <-chan Result[T]) Result[[]T] {
+	out := make([]T, 0)
+	var errs []error
+	for r := range ch {
+		if r.IsErr() {
+			errs = append(errs, r.Err())
+			continue
+		}
+		out = append(out, r.Ok())
+	}
+	if len(errs) > 0 {
+		return Err[[]T](errors.Join(errs...))
+	}
+	return Ok(out)
+}
+
+// teeTo sends r on ch and returns r. Unless block is set a full channel
+// drops r instead of waiting.
+func teeTo[T any](r Result[T], ch chan<- Result[T], block bool) Result[T] {
+	if block {
+		ch <- r
+		return r
+	}
+	select {
+	case ch <- r:
+	default:
+	}
+	return r
+}
+
+// toChans builds the channel pair of ToChans: each has room for one
+// element and at most one of them gets one before both are closed.
+func toChans[T any](t T, err error) (<-chan T, <-chan error) {
+	vals := make(chan T, 1)
+	errs := make(chan error, 1)
+	if err != nil {
+		errs <- err
+	} else {
+		vals <- t
+	}
+	close(vals)
+	close(errs)
+	return vals, errs
+}
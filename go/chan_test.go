@@ -0,0 +1,115 @@
+package cement
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func fanOut(rs ...Result[int]) <-chan Result[int] {
+	ch := make(chan Result[int])
+	wg := sync.WaitGroup{}
+	for _, r := range rs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ch <- r
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+	return ch
+}
+
+func TestCollectChan(t *testing.T) {
+	result := CollectChan(fanOut(Ok(1), Ok(2), Ok(3)))
+	values := result.Ok()
+	slices.Sort(values)
+	assert.DeepEqual(t, values, []int{1, 2, 3})
+
+	assert.Equal(t, len(CollectChan(fanOut()).Ok()), 0)
+}
+
+func TestCollectChanDrainsAfterErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	ch := make(chan Result[int])
+	done := make(chan struct{})
+	go func() {
+		ch <- Err[int](sentinel)
+		ch <- Ok(1)
+		ch <- Ok(2)
+		close(ch)
+		close(done)
+	}()
+	assert.Equal(t, CollectChan(ch).Err(), sentinel)
+	<-done
+}
+
+func TestCollectChanAll(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	result := CollectChanAll(fanOut(Ok(1), Err[int](errA), Ok(2), Err[int](errB)))
+	assert.Assert(t, errors.Is(result.Err(), errA))
+	assert.Assert(t, errors.Is(result.Err(), errB))
+
+	values := CollectChanAll(fanOut(Ok(1), Ok(2))).Ok()
+	slices.Sort(values)
+	assert.DeepEqual(t, values, []int{1, 2})
+}
+
+func TestTeeToBuffered(t *testing.T) {
+	ch := make(chan Result[int], 2)
+	r := Map(Ok(1).TeeTo(ch), func(v int) int { return v + 1 })
+	assert.Equal(t, r.Ok(), 2)
+	Err[int]("boom").TeeTo(ch)
+	assert.Equal(t, (<-ch).Ok(), 1)
+	assert.Error(t, (<-ch).Err(), "boom")
+}
+
+func TestTeeToFullDrops(t *testing.T) {
+	ch := make(chan Result[int], 1)
+	Ok(1).TeeTo(ch)
+	assert.Equal(t, Ok(2).TeeTo(ch).Ok(), 2)
+	assert.Equal(t, len(ch), 1)
+	assert.Equal(t, (<-ch).Ok(), 1)
+
+	unbuffered := make(chan Result[int])
+	assert.Equal(t, OkValue(3).TeeTo(unbuffered).Ok(), 3)
+}
+
+func TestTeeToBlocking(t *testing.T) {
+	ch := make(chan Result[int])
+	done := make(chan Result[int])
+	go func() { done <- Ok(1).TeeToBlocking(ch) }()
+	assert.Equal(t, (<-ch).Ok(), 1)
+	assert.Equal(t, (<-done).Ok(), 1)
+}
+
+func TestToChansOk(t *testing.T) {
+	vals, errs := Ok(1).ToChans()
+	assert.Equal(t, <-vals, 1)
+	_, ok := <-vals
+	assert.Equal(t, ok, false)
+	_, ok = <-errs
+	assert.Equal(t, ok, false)
+}
+
+func TestToChansErr(t *testing.T) {
+	boom := errors.New("boom")
+	vals, errs := Err[int](boom).ToChans()
+	assert.Equal(t, <-errs, boom)
+	_, ok := <-errs
+	assert.Equal(t, ok, false)
+	_, ok = <-vals
+	assert.Equal(t, ok, false)
+
+	vals, errs = ErrValue[int](boom).ToChans()
+	assert.Equal(t, <-errs, boom)
+	_, ok = <-vals
+	assert.Equal(t, ok, false)
+}
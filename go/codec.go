@@ -0,0 +1,44 @@
+package cement
+
+import "encoding/json"
+
+// Codec is a serialization format for Encode and Decode. Other formats
+// plug in with a small adapter, e.g. for gopkg.in/yaml.v3:
+//
+//	type yamlCodec struct{}
+//
+//	func (yamlCodec) Marshal(v any) ([]byte, error)      { return yaml.Marshal(v) }
+//	func (yamlCodec) Unmarshal(data []byte, v any) error { return yaml.Unmarshal(data, v) }
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// JSONCodec is the Codec for encoding/json.
+var JSONCodec Codec = jsonCodec{}
+
+// Encode marshals the Ok value of r with c. An Err is passed through.
+func Encode[T any](c Codec, r Result[T]) Result[[]byte] {
+	return AndThen(r, func(t T) Result[[]byte] {
+		return From(c.Marshal(t))
+	})
+}
+
+// Decode unmarshals data into a fresh T with c.
+func Decode[T any](c Codec, data []byte) Result[T] {
+	var t T
+	if err := c.Unmarshal(data, &t); err != nil {
+		return Err[T](err)
+	}
+	return Ok(t)
+}
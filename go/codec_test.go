@@ -0,0 +1,33 @@
+package cement
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+var errCodec = errors.New("codec failure")
+
+type failingCodec struct{}
+
+func (failingCodec) Marshal(any) ([]byte, error) { return nil, errCodec }
+func (failingCodec) Unmarshal([]byte, any) error { return errCodec }
+
+func TestJSONCodec(t *testing.T) {
+	type point struct {
+		X int `json:"x"`
+	}
+	data := Encode(JSONCodec, Ok(point{X: 1}))
+	assert.Equal(t, string(data.Ok()), `{"x":1}`)
+	assert.Equal(t, Decode[point](JSONCodec, data.Ok()).Ok(), point{X: 1})
+	assert.Assert(t, Decode[point](JSONCodec, []byte(`{`)).IsErr())
+}
+
+func TestCodecErr(t *testing.T) {
+	assert.Equal(t, Encode(failingCodec{}, Ok(1)).Err(), errCodec)
+	assert.Equal(t, Decode[int](failingCodec{}, []byte("1")).Err(), errCodec)
+
+	boom := errors.New("boom")
+	assert.Equal(t, Encode(JSONCodec, Err[int](boom)).Err(), boom)
+}
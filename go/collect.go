@@ -0,0 +1,323 @@
+package cement
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Collect returns Ok with every value of rs, or the Err with the lowest
+// index.
+func Collect[T any](rs []Result[T]) Result[[]T] {
+	out := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.IsErr() {
+			return Err[[]T](r.Err())
+		}
+		out = append(out, r.Ok())
+	}
+	return Ok(out)
+}
+
+// CollectIndexed is Collect that also reports where it failed: the index
+// of the first Err in rs, or -1 when every Result is Ok.
+func CollectIndexed[T any](rs []Result[T]) (Result[[]T], int) {
+	out := make([]T, 0, len(rs))
+	for i, r := range rs {
+		if r.IsErr() {
+			return Err[[]T](r.Err()), i
+		}
+		out = append(out, r.Ok())
+	}
+	return Ok(out), -1
+}
+
+// CollectAll is like Collect but reports every error, joined in order
+// with errors.Join.
+func CollectAll[T any](rs []Result[T]) Result[[]T] {
+	out := make([]T, 0, len(rs))
+	var errs []error
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.Err())
+			continue
+		}
+		out = append(out, r.Ok())
+	}
+	if len(errs) > 0 {
+		return Err[[]T](errors.Join(errs...))
+	}
+	return Ok(out)
+}
+
+// Partition splits rs into its Ok values and its errors, keeping the order
+// within each. Both slices are non-nil.
+func Partition[T any](rs []Result[T]) ([]T, []error) {
+	oks := make([]T, 0, len(rs))
+	errs := make([]error, 0)
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.Err())
+			continue
+		}
+		oks = append(oks, r.Ok())
+	}
+	return oks, errs
+}
+
+// FirstOk returns the first Ok of rs. If every entry is an Err their errors
+// are joined; without any rs an Err reading "no results provided" is
+// returned.
+func FirstOk[T any](rs ...Result[T]) Result[T] {
+	if len(rs) == 0 {
+		return Err[T]("no results provided")
+	}
+	errs := make([]error, 0, len(rs))
+	for _, r := range rs {
+		if r.IsOk() {
+			return r
+		}
+		errs = append(errs, r.Err())
+	}
+	return Err[T](errors.Join(errs...))
+}
+
+// Reduce threads acc through f for every element of xs and stops at the
+// first Err f returns.
+func Reduce[T, A any](xs []T, init A, f func(A, T) Result[A]) Result[A] {
+	acc := init
+	for _, x := range xs {
+		r := f(acc, x)
+		if r.IsErr() {
+			return r
+		}
+		acc = r.Ok()
+	}
+	return Ok(acc)
+}
+
+// Traverse applies f to every element of xs and collects the values,
+// stopping at the first Err. An empty xs yields Ok of an empty slice.
+func Traverse[T, U any](xs []T, f func(T) Result[U]) Result[[]U] {
+	out := make([]U, 0, len(xs))
+	for _, x := range xs {
+		r := f(x)
+		if r.IsErr() {
+			return Err[[]U](r.Err())
+		}
+		out = append(out, r.Ok())
+	}
+	return Ok(out)
+}
+
+// TraverseBatched is Traverse for bulk APIs: f gets xs in chunks of at
+// most batch elements and the Ok outputs are concatenated in order. The
+// first failing chunk stops processing and its Err is returned. batch <= 0
+// hands all of xs to f in one call; an empty xs does not call f at all.
+func TraverseBatched[T, U any](xs []T, batch int, f func([]T) Result[[]U]) Result[[]U] {
+	if batch <= 0 {
+		batch = max(len(xs), 1)
+	}
+	out := make([]U, 0, len(xs))
+	for chunk := range slices.Chunk(xs, batch) {
+		r := f(chunk)
+		if r.IsErr() {
+			return Err[[]U](r.Err())
+		}
+		out = append(out, r.Ok()...)
+	}
+	return Ok(out)
+}
+
+// AllOk reports whether every entry of rs is Ok; it is true for an empty
+// slice.
+func AllOk[T any](rs []Result[T]) bool {
+	for _, r := range rs {
+		if r.IsErr() {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyOk reports whether at least one entry of rs is Ok.
+func AnyOk[T any](rs []Result[T]) bool {
+	for _, r := range rs {
+		if r.IsOk() {
+			return true
+		}
+	}
+	return false
+}
+
+// CountOk returns the number of Ok entries in rs.
+func CountOk[T any](rs []Result[T]) int {
+	n := 0
+	for _, r := range rs {
+		if r.IsOk() {
+			n++
+		}
+	}
+	return n
+}
+
+// CountErr returns the number of Err entries in rs.
+func CountErr[T any](rs []Result[T]) int {
+	return len(rs) - CountOk(rs)
+}
+
+// GroupByOk buckets the Ok values of rs by key and collects the errors in
+// encounter order. The map and the slice are non-nil.
+func GroupByOk[T any, K comparable](rs []Result[T], key func(T) K) (map[K][]T, []error) {
+	groups := make(map[K][]T)
+	errs := make([]error, 0)
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.Err())
+			continue
+		}
+		k := key(r.Ok())
+		groups[k] = append(groups[k], r.Ok())
+	}
+	return groups, errs
+}
+
+// ZipSlices pairs as and bs element by element. Slices of different length
+// are an Err naming both lengths.
+func ZipSlices[A, B any](as []A, bs []B) Result[[]Tuple2[A, B]] {
+	if len(as) != len(bs) {
+		return Err[[]Tuple2[A, B]](fmt.Errorf("ZipSlices: length mismatch %d != %d", len(as), len(bs)))
+	}
+	out := make([]Tuple2[A, B], len(as))
+	for i := range as {
+		out[i] = Tuple2[A, B]{First: as[i], Second: bs[i]}
+	}
+	return Ok(out)
+}
+
+// CollectMap returns Ok with every value of m, or an Err of m. Map
+// iteration order is random, so which error is returned is arbitrary when
+// several entries fail.
+func CollectMap[K comparable, V any](m map[K]Result[V]) Result[map[K]V] {
+	out := make(map[K]V, len(m))
+	for k, r := range m {
+		if r.IsErr() {
+			return Err[map[K]V](r.Err())
+		}
+		out[k] = r.Ok()
+	}
+	return Ok(out)
+}
+
+// CollectMapAll is like CollectMap but joins every error, in map
+// iteration order.
+func CollectMapAll[K comparable, V any](m map[K]Result[V]) Result[map[K]V] {
+	out := make(map[K]V, len(m))
+	var errs []error
+	for k, r := range m {
+		if r.IsErr() {
+			errs = append(errs, r.Err())
+			continue
+		}
+		out[k] = r.Ok()
+	}
+	if len(errs) > 0 {
+		return Err[map[K]V](errors.Join(errs...))
+	}
+	return Ok(out)
+}
+
+// FlattenCollect is Collect over a batch of shards: Ok with the values at
+// the same [shard][item] positions, or the first Err in shard order.
+func FlattenCollect[T any](rss [][]Result[T]) Result[[][]T] {
+	out := make([][]T, 0, len(rss))
+	for _, rs := range rss {
+		r := Collect(rs)
+		if r.IsErr() {
+			return Err[[][]T](r.Err())
+		}
+		out = append(out, r.Ok())
+	}
+	return Ok(out)
+}
+
+// FlattenCollectAll is like FlattenCollect but reports every error across
+// all shards, joined in order with errors.Join.
+func FlattenCollectAll[T any](rss [][]Result[T]) Result[[][]T] {
+	out := make([][]T, 0, len(rss))
+	var errs []error
+	for _, rs := range rss {
+		inner := make([]T, 0, len(rs))
+		for _, r := range rs {
+			if r.IsErr() {
+				errs = append(errs, r.Err())
+				continue
+			}
+			inner = append(inner, r.Ok())
+		}
+		out = append(out, inner)
+	}
+	if len(errs) > 0 {
+		return Err[[][]T](errors.Join(errs...))
+	}
+	return Ok(out)
+}
+
+// JoinErrors joins the errors of rs in order with errors.Join. It is nil
+// when every Result is Ok.
+func JoinErrors[T any](rs []Result[T]) error {
+	var errs []error
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.Err())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Values returns the Ok values of rs in order, skipping the Errs.
+func Values[T any](rs []Result[T]) []T {
+	out := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.IsOk() {
+			out = append(out, r.Ok())
+		}
+	}
+	return out
+}
+
+// Number is the constraint of SumOk: the built-in integer and floating
+// point types and types derived from them.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// SumOk adds up the values of rs, or returns the Err with the lowest
+// index.
+func SumOk[T Number](rs []Result[T]) Result[T] {
+	var sum T
+	for _, r := range rs {
+		if r.IsErr() {
+			return Err[T](r.Err())
+		}
+		sum += r.Ok()
+	}
+	return Ok(sum)
+}
+
+// SumOkAll adds up the Ok values of rs and also reports every error,
+// joined in order with errors.Join; the error is nil if all are Ok.
+func SumOkAll[T Number](rs []Result[T]) (T, error) {
+	var sum T
+	var errs []error
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.Err())
+			continue
+		}
+		sum += r.Ok()
+	}
+	return sum, errors.Join(errs...)
+}
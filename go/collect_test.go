@@ -0,0 +1,334 @@
+package cement
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCollectEmpty(t *testing.T) {
+	result := Collect([]Result[int]{})
+	assert.Equal(t, result.IsOk(), true)
+	assert.Assert(t, result.Ok() != nil)
+	assert.Equal(t, len(result.Ok()), 0)
+}
+
+func TestCollectAllOk(t *testing.T) {
+	result := Collect([]Result[int]{Ok(1), Ok(2), Ok(3)})
+	assert.DeepEqual(t, result.Ok(), []int{1, 2, 3})
+}
+
+func TestCollectMixed(t *testing.T) {
+	first := errors.New("first")
+	result := Collect([]Result[int]{Ok(1), Err[int](first), Err[int]("second")})
+	assert.Equal(t, result.Err(), first)
+}
+
+func TestCollectIndexed(t *testing.T) {
+	result, idx := CollectIndexed([]Result[int]{Ok(1), Ok(2)})
+	assert.DeepEqual(t, result.Ok(), []int{1, 2})
+	assert.Equal(t, idx, -1)
+
+	boom := errors.New("boom")
+	for _, tc := range []struct {
+		rs  []Result[int]
+		idx int
+	}{
+		{[]Result[int]{Err[int](boom), Ok(2), Ok(3)}, 0},
+		{[]Result[int]{Ok(1), Err[int](boom), Err[int]("later")}, 1},
+		{[]Result[int]{Ok(1), Ok(2), Err[int](boom)}, 2},
+	} {
+		result, idx := CollectIndexed(tc.rs)
+		assert.Equal(t, result.Err(), boom)
+		assert.Equal(t, idx, tc.idx)
+	}
+}
+
+func TestCollectAllAccumulates(t *testing.T) {
+	first := errors.New("first")
+	second := errors.New("second")
+	result := CollectAll([]Result[int]{Ok(1), Err[int](first), Ok(2), Err[int](second)})
+	assert.Equal(t, result.IsErr(), true)
+	assert.Assert(t, errors.Is(result.Err(), first))
+	assert.Assert(t, errors.Is(result.Err(), second))
+	assert.Equal(t, result.Err().Error(), "first\nsecond")
+
+	assert.DeepEqual(t, CollectAll([]Result[int]{Ok(1), Ok(2)}).Ok(), []int{1, 2})
+	assert.Equal(t, len(CollectAll([]Result[int]{}).Ok()), 0)
+}
+
+func TestPartition(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	oks, errs := Partition([]Result[int]{Ok(1), Err[int](errA), Ok(2), Err[int](errB), Ok(3)})
+	assert.DeepEqual(t, oks, []int{1, 2, 3})
+	assert.Equal(t, len(errs), 2)
+	assert.Equal(t, errs[0], errA)
+	assert.Equal(t, errs[1], errB)
+}
+
+func TestPartitionEmpty(t *testing.T) {
+	oks, errs := Partition([]Result[int]{})
+	assert.Assert(t, oks != nil)
+	assert.Assert(t, errs != nil)
+	assert.Equal(t, len(oks), 0)
+	assert.Equal(t, len(errs), 0)
+}
+
+func TestFirstOk(t *testing.T) {
+	assert.Equal(t, FirstOk(Err[int]("a"), Ok(2), Ok(3)).Ok(), 2)
+	assert.Equal(t, FirstOk(Ok(1)).Ok(), 1)
+
+	errA := errors.New("a")
+	errB := errors.New("b")
+	result := FirstOk(Err[int](errA), Err[int](errB))
+	assert.Assert(t, errors.Is(result.Err(), errA))
+	assert.Assert(t, errors.Is(result.Err(), errB))
+	assert.Equal(t, result.Err().Error(), "a\nb")
+
+	assert.Equal(t, FirstOk[int]().Err().Error(), "no results provided")
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(acc, x int) Result[int] { return Ok(acc + x) }
+	assert.Equal(t, Reduce([]int{1, 2, 3}, 10, sum).Ok(), 16)
+	assert.Equal(t, Reduce([]int{}, 10, sum).Ok(), 10)
+}
+
+func TestReduceStopsAtFirstErr(t *testing.T) {
+	sentinel := errors.New("negative")
+	calls := 0
+	result := Reduce([]int{1, -1, 2, 3}, 0, func(acc, x int) Result[int] {
+		calls++
+		if x < 0 {
+			return Err[int](sentinel)
+		}
+		return Ok(acc + x)
+	})
+	assert.Equal(t, result.Err(), sentinel)
+	assert.Equal(t, calls, 2)
+}
+
+func parseInt(s string) Result[int] {
+	return From(strconv.Atoi(s))
+}
+
+func TestTraverse(t *testing.T) {
+	assert.DeepEqual(t, Traverse([]string{"1", "2", "3"}, parseInt).Ok(), []int{1, 2, 3})
+
+	empty := Traverse([]string{}, parseInt)
+	assert.Assert(t, empty.Ok() != nil)
+	assert.Equal(t, len(empty.Ok()), 0)
+}
+
+func TestTraverseShortCircuits(t *testing.T) {
+	calls := 0
+	result := Traverse([]string{"1", "x", "3"}, func(s string) Result[int] {
+		calls++
+		return parseInt(s)
+	})
+	assert.Equal(t, result.IsErr(), true)
+	assert.ErrorContains(t, result.Err(), `parsing "x"`)
+	assert.Equal(t, calls, 2)
+}
+
+func benchInput() []string {
+	xs := make([]string, 1000)
+	for i := range xs {
+		xs[i] = strconv.Itoa(i)
+	}
+	return xs
+}
+
+func BenchmarkTraverse(b *testing.B) {
+	xs := benchInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Traverse(xs, parseInt)
+	}
+}
+
+func BenchmarkCollectMapped(b *testing.B) {
+	xs := benchInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		rs := make([]Result[int], 0, len(xs))
+		for _, x := range xs {
+			rs = append(rs, parseInt(x))
+		}
+		Collect(rs)
+	}
+}
+
+func TestAllAnyOk(t *testing.T) {
+	mixed := []Result[int]{Ok(1), Err[int]("a"), Ok(2)}
+	assert.Equal(t, AllOk(mixed), false)
+	assert.Equal(t, AnyOk(mixed), true)
+	assert.Equal(t, CountOk(mixed), 2)
+	assert.Equal(t, CountErr(mixed), 1)
+
+	assert.Equal(t, AllOk([]Result[int]{Ok(1)}), true)
+	assert.Equal(t, AnyOk([]Result[int]{Err[int]("a")}), false)
+
+	assert.Equal(t, AllOk([]Result[int]{}), true)
+	assert.Equal(t, AnyOk([]Result[int]{}), false)
+	assert.Equal(t, CountOk([]Result[int]{}), 0)
+	assert.Equal(t, CountErr([]Result[int]{}), 0)
+}
+
+func TestGroupByOk(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	parity := func(i int) string {
+		if i%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+	groups, errs := GroupByOk([]Result[int]{
+		Ok(1), Err[int](errA), Ok(2), Ok(3), Err[int](errB), Ok(4),
+	}, parity)
+	assert.DeepEqual(t, groups, map[string][]int{"odd": {1, 3}, "even": {2, 4}})
+	assert.Equal(t, len(errs), 2)
+	assert.Equal(t, errs[0], errA)
+	assert.Equal(t, errs[1], errB)
+}
+
+func TestGroupByOkEmpty(t *testing.T) {
+	groups, errs := GroupByOk([]Result[int]{}, func(i int) int { return i })
+	assert.Assert(t, groups != nil)
+	assert.Assert(t, errs != nil)
+	assert.Equal(t, len(groups), 0)
+	assert.Equal(t, len(errs), 0)
+}
+
+func TestZipSlices(t *testing.T) {
+	result := ZipSlices([]string{"a", "b"}, []int{1, 2})
+	assert.DeepEqual(t, result.Ok(), []Tuple2[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}})
+
+	empty := ZipSlices([]string{}, []int{})
+	assert.Assert(t, empty.Ok() != nil)
+	assert.Equal(t, len(empty.Ok()), 0)
+
+	assert.Equal(t, ZipSlices([]string{"a"}, []int{1, 2}).Err().Error(), "ZipSlices: length mismatch 1 != 2")
+}
+
+func TestCollectMap(t *testing.T) {
+	result := CollectMap(map[string]Result[int]{"a": Ok(1), "b": Ok(2)})
+	assert.DeepEqual(t, result.Ok(), map[string]int{"a": 1, "b": 2})
+	assert.Equal(t, len(CollectMap(map[string]Result[int]{}).Ok()), 0)
+
+	sentinel := errors.New("sentinel")
+	result = CollectMap(map[string]Result[int]{"a": Ok(1), "b": Err[int](sentinel), "c": Ok(3)})
+	assert.Equal(t, result.Err(), sentinel)
+}
+
+func TestCollectMapAll(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	result := CollectMapAll(map[string]Result[int]{"a": Err[int](errA), "b": Err[int](errB), "c": Ok(3)})
+	assert.Assert(t, errors.Is(result.Err(), errA))
+	assert.Assert(t, errors.Is(result.Err(), errB))
+	assert.DeepEqual(t, CollectMapAll(map[string]Result[int]{"c": Ok(3)}).Ok(), map[string]int{"c": 3})
+}
+
+func TestFlattenCollect(t *testing.T) {
+	result := FlattenCollect([][]Result[int]{{Ok(1), Ok(2)}, {}, {Ok(3)}})
+	assert.DeepEqual(t, result.Ok(), [][]int{{1, 2}, {}, {3}})
+
+	mid := errors.New("mid")
+	result = FlattenCollect([][]Result[int]{{Ok(1)}, {Ok(2), Err[int](mid)}, {Err[int]("last")}})
+	assert.Equal(t, result.Err(), mid)
+}
+
+func TestFlattenCollectAll(t *testing.T) {
+	mid := errors.New("mid")
+	last := errors.New("last")
+	result := FlattenCollectAll([][]Result[int]{{Ok(1)}, {Ok(2), Err[int](mid)}, {Err[int](last)}})
+	assert.Assert(t, errors.Is(result.Err(), mid))
+	assert.Assert(t, errors.Is(result.Err(), last))
+	assert.Equal(t, result.Err().Error(), "mid\nlast")
+
+	assert.DeepEqual(t, FlattenCollectAll([][]Result[int]{{Ok(1)}, {Ok(2), Ok(3)}}).Ok(), [][]int{{1}, {2, 3}})
+}
+
+func TestJoinErrors(t *testing.T) {
+	assert.Assert(t, JoinErrors([]Result[int]{Ok(1), Ok(2)}) == nil)
+	assert.Assert(t, JoinErrors([]Result[int]{}) == nil)
+
+	errA := errors.New("a")
+	errB := errors.New("b")
+	err := JoinErrors([]Result[int]{Err[int](errA), Ok(1), Err[int](errB)})
+	assert.Assert(t, errors.Is(err, errA))
+	assert.Assert(t, errors.Is(err, errB))
+	assert.Error(t, err, "a\nb")
+}
+
+func TestValues(t *testing.T) {
+	assert.DeepEqual(t, Values([]Result[int]{Ok(1), Err[int]("x"), Ok(3)}), []int{1, 3})
+	assert.DeepEqual(t, Values([]Result[int]{Err[int]("x")}), []int{})
+}
+
+func TestSumOk(t *testing.T) {
+	assert.Equal(t, SumOk([]Result[int]{Ok(1), Ok(2), Ok(3)}).Ok(), 6)
+	assert.Equal(t, SumOk([]Result[float64]{Ok(0.5), Ok(0.25)}).Ok(), 0.75)
+	assert.Equal(t, SumOk([]Result[int]{}).Ok(), 0)
+
+	type cents int64
+	assert.Equal(t, SumOk([]Result[cents]{Ok(cents(150)), Ok(cents(50))}).Ok(), cents(200))
+
+	first := errors.New("first")
+	assert.Equal(t, SumOk([]Result[int]{Ok(1), Err[int](first), Err[int]("second")}).Err(), first)
+}
+
+func TestSumOkAll(t *testing.T) {
+	sum, err := SumOkAll([]Result[int]{Ok(1), Ok(2)})
+	assert.Equal(t, sum, 3)
+	assert.NilError(t, err)
+
+	errA := errors.New("a")
+	errB := errors.New("b")
+	sum, err = SumOkAll([]Result[int]{Ok(1), Err[int](errA), Ok(4), Err[int](errB)})
+	assert.Equal(t, sum, 5)
+	assert.Assert(t, errors.Is(err, errA))
+	assert.Assert(t, errors.Is(err, errB))
+}
+
+func TestTraverseBatched(t *testing.T) {
+	var sizes []int
+	double := func(xs []int) Result[[]int] {
+		sizes = append(sizes, len(xs))
+		out := make([]int, len(xs))
+		for i, x := range xs {
+			out[i] = x * 2
+		}
+		return Ok(out)
+	}
+	xs := []int{1, 2, 3, 4, 5, 6, 7}
+	assert.DeepEqual(t, TraverseBatched(xs, 3, double).Ok(), []int{2, 4, 6, 8, 10, 12, 14})
+	assert.DeepEqual(t, sizes, []int{3, 3, 1})
+
+	sizes = nil
+	assert.DeepEqual(t, TraverseBatched(xs, 0, double).Ok(), []int{2, 4, 6, 8, 10, 12, 14})
+	assert.DeepEqual(t, sizes, []int{7})
+
+	sizes = nil
+	assert.DeepEqual(t, TraverseBatched([]int{}, 0, double).Ok(), []int{})
+	assert.Equal(t, len(sizes), 0)
+}
+
+func TestTraverseBatchedStopsAtFailingChunk(t *testing.T) {
+	mid := errors.New("mid")
+	var seen [][]int
+	r := TraverseBatched([]int{1, 2, 3, 4, 5, 6}, 2, func(xs []int) Result[[]int] {
+		seen = append(seen, xs)
+		if xs[0] == 3 {
+			return Err[[]int](mid)
+		}
+		return Ok(xs)
+	})
+	assert.Equal(t, r.Err(), mid)
+	assert.DeepEqual(t, seen, [][]int{{1, 2}, {3, 4}})
+}
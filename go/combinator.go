@@ -0,0 +1,233 @@
+package cement
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Map transforms the Ok value of r with f. An Err is forwarded as Err[U]
+// with the original error untouched and f is never called.
+func Map[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.IsErr() {
+		return errMeta[U](r.Err(), metaOf(r))
+	}
+	return okMeta(f(r.Ok()), metaOf(r))
+}
+
+// AndThen calls f with the Ok value of r and returns its Result. An Err is
+// forwarded as Err[U] with the original error and f is never called.
+func AndThen[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	return Join(Map(r, f))
+}
+
+// And returns other if r is Ok and r's error otherwise, the eager form of
+// AndThen. It is a function rather than a method because Go methods cannot
+// introduce the type parameter U.
+func And[T, U any](r Result[T], other Result[U]) Result[U] {
+	if r.IsErr() {
+		return errMeta[U](r.Err(), metaOf(r))
+	}
+	return other
+}
+
+// Match collapses r into a single value by calling onOk or onErr.
+func Match[T, R any](r Result[T], onOk func(T) R, onErr func(error) R) R {
+	if r.IsErr() {
+		return onErr(r.Err())
+	}
+	return onOk(r.Ok())
+}
+
+// Join is the monadic join: it collapses one level of nesting. An outer
+// Err is forwarded as Err[T]; an Ok outer yields the inner Result
+// untouched. Deeper nesting such as Result[Result[Result[T]]] takes one
+// Join per level.
+func Join[T any](r Result[Result[T]]) Result[T] {
+	if r.IsErr() {
+		return errMeta[T](r.Err(), metaOf(r))
+	}
+	return withMeta(r.Ok(), metaOf(r))
+}
+
+// Flatten is Join.
+func Flatten[T any](r Result[Result[T]]) Result[T] {
+	return Join(r)
+}
+
+// Zip pairs the values of a and b. If either is an Err that error is
+// returned, a's error when both fail.
+func Zip[A, B any](a Result[A], b Result[B]) Result[Tuple2[A, B]] {
+	return ZipWith(a, b, func(av A, bv B) Tuple2[A, B] {
+		return Tuple2[A, B]{First: av, Second: bv}
+	})
+}
+
+// Merge is Zip with error accumulation: if both a and b are Errs the
+// result joins a's error then b's with errors.Join, if only one is, its
+// error is passed through.
+func Merge[A, B any](a Result[A], b Result[B]) Result[Tuple2[A, B]] {
+	if a.IsErr() && b.IsErr() {
+		return Err[Tuple2[A, B]](errors.Join(a.Err(), b.Err()))
+	}
+	return Zip(a, b)
+}
+
+// AndZip gathers r and others into one Result of their values in order,
+// or the leftmost error: AndZip(a(), b(), c()). It cannot be a method: a
+// method of Result[T] returning Result[[]T] would make the interface
+// instantiate itself without end, which Go rejects as a cycle.
+func AndZip[T any](r Result[T], others ...Result[T]) Result[[]T] {
+	return Collect(append([]Result[T]{r}, others...))
+}
+
+// ZipWith combines the values of a and b with f, with the same error
+// precedence as Zip. f is not called if either is an Err.
+func ZipWith[A, B, C any](a Result[A], b Result[B], f func(A, B) C) Result[C] {
+	if a.IsErr() {
+		return Err[C](a.Err())
+	}
+	if b.IsErr() {
+		return Err[C](b.Err())
+	}
+	return Ok(f(a.Ok(), b.Ok()))
+}
+
+// MapOr returns f applied to the Ok value, or def for an Err.
+func MapOr[T, U any](r Result[T], def U, f func(T) U) U {
+	if r.IsErr() {
+		return def
+	}
+	return f(r.Ok())
+}
+
+// MapOrElse returns onOk applied to the Ok value, or onErr applied to the
+// error.
+func MapOrElse[T, U any](r Result[T], onErr func(error) U, onOk func(T) U) U {
+	if r.IsErr() {
+		return onErr(r.Err())
+	}
+	return onOk(r.Ok())
+}
+
+// Apply calls the function held by rf with the value held by ra. Errors
+// take precedence left to right: rf's error wins over ra's.
+func Apply[A, B any](rf Result[func(A) B], ra Result[A]) Result[B] {
+	if rf.IsErr() {
+		return Err[B](rf.Err())
+	}
+	if ra.IsErr() {
+		return Err[B](ra.Err())
+	}
+	return Ok(rf.Ok()(ra.Ok()))
+}
+
+// Bimap maps the Ok value with onOk or the error with onErr. Unlike
+// MapOrElse the result stays a Result: onErr returns a new error, not a
+// fallback value.
+func Bimap[T, U any](r Result[T], onOk func(T) U, onErr func(error) error) Result[U] {
+	if r.IsErr() {
+		return errMeta[U](onErr(r.Err()), metaOf(r))
+	}
+	return okMeta(onOk(r.Ok()), metaOf(r))
+}
+
+// Sequence2 is Zip: Ok of both values or the leftmost error.
+func Sequence2[A, B any](a Result[A], b Result[B]) Result[Tuple2[A, B]] {
+	return Zip(a, b)
+}
+
+// Sequence3 is Ok of all three values or the leftmost error.
+func Sequence3[A, B, C any](a Result[A], b Result[B], c Result[C]) Result[Tuple3[A, B, C]] {
+	return Map3(a, b, c, func(av A, bv B, cv C) Tuple3[A, B, C] {
+		return Tuple3[A, B, C]{First: av, Second: bv, Third: cv}
+	})
+}
+
+// Map2 is ZipWith: f applied to both values, or the leftmost error.
+func Map2[A, B, C any](a Result[A], b Result[B], f func(A, B) C) Result[C] {
+	return ZipWith(a, b, f)
+}
+
+// Map3 applies f to the three values, or returns the leftmost error
+// without calling f.
+func Map3[A, B, C, D any](a Result[A], b Result[B], c Result[C], f func(A, B, C) D) Result[D] {
+	if a.IsErr() {
+		return Err[D](a.Err())
+	}
+	if b.IsErr() {
+		return Err[D](b.Err())
+	}
+	if c.IsErr() {
+		return Err[D](c.Err())
+	}
+	return Ok(f(a.Ok(), b.Ok(), c.Ok()))
+}
+
+// Map4 is Map3 for four Results.
+func Map4[A, B, C, D, E any](a Result[A], b Result[B], c Result[C], d Result[D], f func(A, B, C, D) E) Result[E] {
+	if a.IsErr() {
+		return Err[E](a.Err())
+	}
+	if b.IsErr() {
+		return Err[E](b.Err())
+	}
+	if c.IsErr() {
+		return Err[E](c.Err())
+	}
+	if d.IsErr() {
+		return Err[E](d.Err())
+	}
+	return Ok(f(a.Ok(), b.Ok(), c.Ok(), d.Ok()))
+}
+
+// Map5 is Map3 for five Results.
+func Map5[A, B, C, D, E, F any](a Result[A], b Result[B], c Result[C], d Result[D], e Result[E], f func(A, B, C, D, E) F) Result[F] {
+	if a.IsErr() {
+		return Err[F](a.Err())
+	}
+	if b.IsErr() {
+		return Err[F](b.Err())
+	}
+	if c.IsErr() {
+		return Err[F](c.Err())
+	}
+	if d.IsErr() {
+		return Err[F](d.Err())
+	}
+	if e.IsErr() {
+		return Err[F](e.Err())
+	}
+	return Ok(f(a.Ok(), b.Ok(), c.Ok(), d.Ok(), e.Ok()))
+}
+
+// Clone returns r with its Ok value copied by deep. Errors are never
+// copied and stay shared, and with a nil deep r is returned as is: copying
+// a Result by itself never copies what T points to.
+func Clone[T any](r Result[T], deep func(T) T) Result[T] {
+	if deep == nil {
+		return r
+	}
+	return Map(r, deep)
+}
+
+// CloneSlice is Clone with a copy of the backing array. The elements
+// themselves are copied shallowly.
+func CloneSlice[T any](r Result[[]T]) Result[[]T] {
+	return Clone(r, slices.Clone[[]T])
+}
+
+// Cast type-asserts the Ok value of r to T, the safe downcast for Results
+// crossing an any boundary. A failed assertion is an Err naming both the
+// expected and the dynamic type; an Err is passed through.
+func Cast[T any](r Result[any]) Result[T] {
+	if r.IsErr() {
+		return errMeta[T](r.Err(), metaOf(r))
+	}
+	v, ok := r.Ok().(T)
+	if !ok {
+		return errMeta[T](fmt.Errorf("expected %v, got %T", reflect.TypeFor[T](), r.Ok()), metaOf(r))
+	}
+	return okMeta(v, metaOf(r))
+}
@@ -0,0 +1,356 @@
+package cement
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMapOk(t *testing.T) {
+	result := Map(Ok(21), func(i int) string { return strconv.Itoa(i * 2) })
+	assert.Equal(t, result.IsOk(), true)
+	assert.Equal(t, result.Ok(), "42")
+}
+
+func TestMapErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	called := false
+	result := Map(Err[int](sentinel), func(i int) string {
+		called = true
+		return strconv.Itoa(i)
+	})
+	assert.Equal(t, called, false)
+	assert.Equal(t, result.IsErr(), true)
+	assert.Assert(t, errors.Is(result.Err(), sentinel))
+}
+
+func TestMapMethod(t *testing.T) {
+	assert.Equal(t, Ok(2).Map(func(i int) int { return i + 1 }).Ok(), 3)
+	called := false
+	result := Err[int]("xxx").Map(func(i int) int {
+		called = true
+		return i
+	})
+	assert.Equal(t, called, false)
+	assert.Equal(t, result.Err().Error(), "xxx")
+}
+
+func TestAndThenChain(t *testing.T) {
+	sentinel := errors.New("middle failed")
+	calls := []string{}
+	parse := func(s string) Result[int] {
+		calls = append(calls, "parse")
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return Err[int](err)
+		}
+		return Ok(i)
+	}
+	check := func(i int) Result[int] {
+		calls = append(calls, "check")
+		return Err[int](sentinel)
+	}
+	format := func(i int) Result[string] {
+		calls = append(calls, "format")
+		return Ok(strconv.Itoa(i))
+	}
+	result := AndThen(AndThen(parse("42"), check), format)
+	assert.Equal(t, result.IsErr(), true)
+	assert.Equal(t, result.Err(), sentinel)
+	assert.DeepEqual(t, calls, []string{"parse", "check"})
+}
+
+func TestAndThenOk(t *testing.T) {
+	double := func(i int) Result[int] { return Ok(i * 2) }
+	result := AndThen(AndThen(AndThen(Ok(1), double), double), func(i int) Result[string] {
+		return Ok(strconv.Itoa(i))
+	})
+	assert.Equal(t, result.Ok(), "4")
+}
+
+func TestMatch(t *testing.T) {
+	onOk := func(i int) string { return "ok:" + strconv.Itoa(i) }
+	onErr := func(err error) string { return "err:" + err.Error() }
+	assert.Equal(t, Match(Ok(1), onOk, onErr), "ok:1")
+	assert.Equal(t, Match(Err[int]("xxx"), onOk, onErr), "err:xxx")
+
+	sentinel := errors.New("sentinel")
+	Match(Err[int](sentinel), func(int) bool {
+		t.Fatal("onOk called for Err")
+		return false
+	}, func(err error) bool {
+		assert.Equal(t, err, sentinel)
+		return true
+	})
+}
+
+func TestFold(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+	length := func(err error) int { return len(err.Error()) }
+	assert.Equal(t, Ok(2).Fold(double, length), 4)
+	assert.Equal(t, Err[int]("xxx").Fold(double, length), 3)
+}
+
+func TestFlatten(t *testing.T) {
+	assert.Equal(t, Flatten(Ok(Ok(1))).Ok(), 1)
+
+	inner := errors.New("inner")
+	assert.Equal(t, Flatten(Ok(Err[int](inner))).Err(), inner)
+
+	outer := errors.New("outer")
+	assert.Equal(t, Flatten(Err[Result[int]](outer)).Err(), outer)
+}
+
+func TestZip(t *testing.T) {
+	result := Zip(Ok("localhost"), Ok(8080))
+	assert.Equal(t, result.Ok(), Tuple2[string, int]{First: "localhost", Second: 8080})
+
+	errA := errors.New("a")
+	errB := errors.New("b")
+	assert.Equal(t, Zip(Err[string](errA), Ok(1)).Err(), errA)
+	assert.Equal(t, Zip(Ok("x"), Err[int](errB)).Err(), errB)
+	assert.Equal(t, Zip(Err[string](errA), Err[int](errB)).Err(), errA)
+}
+
+func TestZipWith(t *testing.T) {
+	join := func(host string, port int) string { return host + ":" + strconv.Itoa(port) }
+	assert.Equal(t, ZipWith(Ok("localhost"), Ok(8080), join).Ok(), "localhost:8080")
+	result := ZipWith(Ok("localhost"), Err[int]("bad port"), func(string, int) string {
+		t.Fatal("f called for Err")
+		return ""
+	})
+	assert.Equal(t, result.Err().Error(), "bad port")
+}
+
+func TestMapOr(t *testing.T) {
+	assert.Equal(t, MapOr(Ok(2), "none", strconv.Itoa), "2")
+	assert.Equal(t, MapOr(Err[int]("xxx"), "none", func(int) string {
+		t.Fatal("f called for Err")
+		return ""
+	}), "none")
+}
+
+func TestMapOrElse(t *testing.T) {
+	onErr := func(err error) string { return "err:" + err.Error() }
+	assert.Equal(t, MapOrElse(Ok(2), onErr, strconv.Itoa), "2")
+	assert.Equal(t, MapOrElse(Err[int]("xxx"), onErr, func(int) string {
+		t.Fatal("onOk called for Err")
+		return ""
+	}), "err:xxx")
+}
+
+func TestApply(t *testing.T) {
+	double := Ok(func(i int) string { return strconv.Itoa(i * 2) })
+	assert.Equal(t, Apply(double, Ok(21)).Ok(), "42")
+
+	errF := errors.New("f")
+	errA := errors.New("a")
+	assert.Equal(t, Apply(Err[func(int) string](errF), Ok(21)).Err(), errF)
+	assert.Equal(t, Apply(double, Err[int](errA)).Err(), errA)
+	assert.Equal(t, Apply(Err[func(int) string](errF), Err[int](errA)).Err(), errF)
+}
+
+func TestApplyCurried(t *testing.T) {
+	add := func(a int) func(int) int {
+		return func(b int) int { return a + b }
+	}
+	assert.Equal(t, Apply(Map(Ok(1), add), Ok(2)).Ok(), 3)
+}
+
+func TestBimap(t *testing.T) {
+	onErr := func(err error) error { return fmt.Errorf("layer: %w", err) }
+	assert.Equal(t, Bimap(Ok(1), strconv.Itoa, func(err error) error {
+		t.Fatal("onErr called for Ok")
+		return err
+	}).Ok(), "1")
+
+	sentinel := errors.New("sentinel")
+	result := Bimap(Err[int](sentinel), func(int) string {
+		t.Fatal("onOk called for Err")
+		return ""
+	}, onErr)
+	assert.Equal(t, result.Err().Error(), "layer: sentinel")
+	assert.Assert(t, errors.Is(result.Err(), sentinel))
+}
+
+func TestSequence2(t *testing.T) {
+	assert.Equal(t, Sequence2(Ok(1), Ok("x")).Ok(), Tuple2[int, string]{First: 1, Second: "x"})
+	errA := errors.New("a")
+	assert.Equal(t, Sequence2(Err[int](errA), Err[string]("b")).Err(), errA)
+}
+
+func TestSequence3(t *testing.T) {
+	result := Sequence3(Ok(1), Ok("x"), Ok(true))
+	assert.Equal(t, result.Ok(), Tuple3[int, string, bool]{First: 1, Second: "x", Third: true})
+
+	errA := errors.New("a")
+	errB := errors.New("b")
+	errC := errors.New("c")
+	assert.Equal(t, Sequence3(Err[int](errA), Err[string](errB), Err[bool](errC)).Err(), errA)
+	assert.Equal(t, Sequence3(Ok(1), Err[string](errB), Err[bool](errC)).Err(), errB)
+	assert.Equal(t, Sequence3(Ok(1), Ok("x"), Err[bool](errC)).Err(), errC)
+}
+
+func TestJoinDeep(t *testing.T) {
+	deep := Ok(Ok(Ok(1)))
+	assert.Equal(t, Join(Join(deep)).Ok(), 1)
+
+	inner := errors.New("inner")
+	assert.Equal(t, Join(Join(Ok(Ok(Err[int](inner))))).Err(), inner)
+}
+
+func TestJoinAgreesWithAndThen(t *testing.T) {
+	outer := errors.New("outer")
+	inner := errors.New("inner")
+	fs := []func(int) Result[string]{
+		func(i int) Result[string] { return Ok(strconv.Itoa(i)) },
+		func(int) Result[string] { return Err[string](inner) },
+	}
+	for _, r := range []Result[int]{Ok(1), Err[int](outer)} {
+		for _, f := range fs {
+			assert.Assert(t, Equals(AndThen(r, f), Join(Map(r, f))))
+			assert.Assert(t, Equals(AndThen(r, f), Flatten(Map(r, f))))
+		}
+	}
+}
+
+func TestMap2(t *testing.T) {
+	assert.Equal(t, Map2(Ok(1), Ok(2), func(a, b int) int { return a + b }).Ok(), 3)
+	errA := errors.New("a")
+	result := Map2(Err[int](errA), Err[int]("b"), func(a, b int) int {
+		t.Fatal("f called for Err")
+		return 0
+	})
+	assert.Equal(t, result.Err(), errA)
+}
+
+func TestMap3(t *testing.T) {
+	sum := func(a, b, c int) int { return a + b + c }
+	assert.Equal(t, Map3(Ok(1), Ok(2), Ok(3), sum).Ok(), 6)
+
+	errB := errors.New("b")
+	errC := errors.New("c")
+	called := false
+	result := Map3(Ok(1), Err[int](errB), Err[int](errC), func(a, b, c int) int {
+		called = true
+		return 0
+	})
+	assert.Equal(t, result.Err(), errB)
+	assert.Equal(t, Map3(Ok(1), Ok(2), Err[int](errC), sum).Err(), errC)
+	assert.Equal(t, called, false)
+}
+
+func TestMap4(t *testing.T) {
+	sum := func(a, b, c, d int) int { return a + b + c + d }
+	assert.Equal(t, Map4(Ok(1), Ok(2), Ok(3), Ok(4), sum).Ok(), 10)
+
+	errC := errors.New("c")
+	errD := errors.New("d")
+	called := false
+	result := Map4(Ok(1), Ok(2), Err[int](errC), Err[int](errD), func(a, b, c, d int) int {
+		called = true
+		return 0
+	})
+	assert.Equal(t, result.Err(), errC)
+	assert.Equal(t, Map4(Ok(1), Ok(2), Ok(3), Err[int](errD), sum).Err(), errD)
+	assert.Equal(t, called, false)
+}
+
+func TestMap5(t *testing.T) {
+	type row struct {
+		ID    int
+		Name  string
+		Admin bool
+		Score float64
+		Tag   string
+	}
+	build := func(id int, name string, admin bool, score float64, tag string) row {
+		return row{id, name, admin, score, tag}
+	}
+	r := Map5(Ok(1), Ok("ann"), Ok(true), Ok(2.5), Ok("x"), build)
+	assert.Equal(t, r.Ok(), row{1, "ann", true, 2.5, "x"})
+
+	errName := errors.New("name")
+	errTag := errors.New("tag")
+	r = Map5(Ok(1), Err[string](errName), Ok(true), Ok(2.5), Err[string](errTag), build)
+	assert.Equal(t, r.Err(), errName)
+	r = Map5(Ok(1), Ok("ann"), Ok(true), Ok(2.5), Err[string](errTag), build)
+	assert.Equal(t, r.Err(), errTag)
+}
+
+func TestClone(t *testing.T) {
+	orig := Ok(map[string]int{"a": 1})
+	clone := Clone(orig, func(m map[string]int) map[string]int {
+		out := map[string]int{}
+		for k, v := range m {
+			out[k] = v
+		}
+		return out
+	})
+	clone.Ok()["a"] = 2
+	assert.Equal(t, orig.Ok()["a"], 1)
+
+	shared := Clone(orig, nil)
+	shared.Ok()["a"] = 3
+	assert.Equal(t, orig.Ok()["a"], 3)
+
+	sentinel := errors.New("sentinel")
+	assert.Equal(t, Clone(Err[map[string]int](sentinel), func(m map[string]int) map[string]int {
+		t.Fatal("deep called for Err")
+		return m
+	}).Err(), sentinel)
+}
+
+func TestCloneSlice(t *testing.T) {
+	orig := Ok([]int{1, 2, 3})
+	clone := CloneSlice(orig)
+	clone.Ok()[0] = 9
+	assert.DeepEqual(t, orig.Ok(), []int{1, 2, 3})
+	assert.DeepEqual(t, clone.Ok(), []int{9, 2, 3})
+}
+
+func TestMerge(t *testing.T) {
+	assert.Equal(t, Merge(Ok(1), Ok("a")).Ok(), Tuple2[int, string]{First: 1, Second: "a"})
+
+	errA := errors.New("a")
+	errB := errors.New("b")
+	assert.Equal(t, Merge(Err[int](errA), Ok("a")).Err(), errA)
+	assert.Equal(t, Merge(Ok(1), Err[string](errB)).Err(), errB)
+
+	both := Merge(Err[int](errA), Err[string](errB)).Err()
+	assert.Assert(t, errors.Is(both, errA))
+	assert.Assert(t, errors.Is(both, errB))
+	assert.Error(t, both, "a\nb")
+}
+
+func TestCast(t *testing.T) {
+	assert.Equal(t, Cast[int](Ok[any](1)).Ok(), 1)
+	assert.Equal(t, Cast[fmt.Stringer](Ok[any](testStringer{})).Ok().String(), "stringer")
+
+	assert.Error(t, Cast[int](Ok[any]("one")).Err(), "expected int, got string")
+	assert.Error(t, Cast[string](Ok[any](nil)).Err(), "expected string, got <nil>")
+
+	boom := errors.New("boom")
+	assert.Equal(t, Cast[int](Err[any](boom)).Err(), boom)
+}
+
+func TestAnd(t *testing.T) {
+	assert.Equal(t, And(Ok(1), Ok("a")).Ok(), "a")
+	assert.Error(t, And(Ok(1), Err[string]("other")).Err(), "other")
+
+	boom := errors.New("boom")
+	assert.Equal(t, And(Err[int](boom), Ok("a")).Err(), boom)
+	assert.Equal(t, And(Err[int](boom), Err[string]("other")).Err(), boom)
+}
+
+func TestAndZip(t *testing.T) {
+	assert.DeepEqual(t, AndZip(Ok(1), Ok(2), Ok(3)).Ok(), []int{1, 2, 3})
+	assert.DeepEqual(t, AndZip(Ok(1)).Ok(), []int{1})
+
+	mid := errors.New("mid")
+	assert.Equal(t, AndZip(Ok(1), Err[int](mid), Err[int]("last")).Err(), mid)
+	assert.Error(t, AndZip(Err[int]("first"), Err[int](mid)).Err(), "first")
+}
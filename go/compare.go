@@ -0,0 +1,68 @@
+package cement
+
+import (
+	"cmp"
+	"errors"
+)
+
+// Equals reports whether a and b are both Ok with equal values or both Err
+// with the same error message. T must be comparable for the Ok path.
+func Equals[T comparable](a, b Result[T]) bool {
+	if a.IsOk() && b.IsOk() {
+		return a.Ok() == b.Ok()
+	}
+	if a.IsErr() && b.IsErr() {
+		return a.Err().Error() == b.Err().Error()
+	}
+	return false
+}
+
+// EqualsErr is like Equals but compares errors by identity with
+// errors.Is(a.Err(), b.Err()) instead of by message.
+func EqualsErr[T comparable](a, b Result[T]) bool {
+	if a.IsOk() && b.IsOk() {
+		return a.Ok() == b.Ok()
+	}
+	if a.IsErr() && b.IsErr() {
+		return errors.Is(a.Err(), b.Err())
+	}
+	return false
+}
+
+// EqualsFunc generalizes Equals to any T: valEq compares two Ok values and
+// errEq two errors. Each is only called when both sides are of its branch.
+func EqualsFunc[T any](a, b Result[T], valEq func(T, T) bool, errEq func(error, error) bool) bool {
+	if a.IsOk() && b.IsOk() {
+		return valEq(a.Ok(), b.Ok())
+	}
+	if a.IsErr() && b.IsErr() {
+		return errEq(a.Err(), b.Err())
+	}
+	return false
+}
+
+// Contains reports whether r is Ok and holds want.
+func Contains[T comparable](r Result[T], want T) bool {
+	return r.IsOk() && r.Ok() == want
+}
+
+// ContainsErr reports whether r is an Err whose chain matches target.
+func ContainsErr[T any](r Result[T], target error) bool {
+	return r.IsErr() && errors.Is(r.Err(), target)
+}
+
+// Compare orders Results for slices.SortFunc: two Oks compare by value, an
+// Ok sorts before an Err, and two Errs are equal regardless of their
+// errors, so a stable sort keeps failures in their original order.
+func Compare[T cmp.Ordered](a, b Result[T]) int {
+	switch {
+	case a.IsOk() && b.IsOk():
+		return cmp.Compare(a.Ok(), b.Ok())
+	case a.IsOk():
+		return -1
+	case b.IsOk():
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,93 @@
+package cement
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestEquals(t *testing.T) {
+	for _, tc := range []struct {
+		a, b Result[int]
+		want bool
+	}{
+		{Ok(1), Ok(1), true},
+		{Ok(1), Ok(2), false},
+		{Err[int]("xxx"), Err[int]("xxx"), true},
+		{Err[int]("xxx"), Err[int]("yyy"), false},
+		{Ok(1), Err[int]("xxx"), false},
+		{Err[int]("xxx"), Ok(1), false},
+	} {
+		assert.Equal(t, Equals(tc.a, tc.b), tc.want, "%v == %v", tc.a, tc.b)
+	}
+}
+
+func TestEqualsErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	wrapped := Err[int](fmt.Errorf("wrapped: %w", sentinel))
+	assert.Equal(t, EqualsErr(wrapped, Err[int](sentinel)), true)
+	assert.Equal(t, EqualsErr(Err[int]("sentinel"), Err[int](sentinel)), false)
+	assert.Equal(t, Equals(Err[int]("sentinel"), Err[int](sentinel)), true)
+	assert.Equal(t, EqualsErr(Ok(1), Ok(1)), true)
+	assert.Equal(t, EqualsErr(Ok(1), Err[int](sentinel)), false)
+}
+
+func TestContains(t *testing.T) {
+	assert.Equal(t, Contains(Ok(1), 1), true)
+	assert.Equal(t, Contains(Ok(1), 2), false)
+	assert.Equal(t, Contains(Err[int]("xxx"), 0), false)
+}
+
+func TestContainsErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	assert.Equal(t, ContainsErr(Err[int](fmt.Errorf("wrapped: %w", sentinel)), sentinel), true)
+	assert.Equal(t, ContainsErr(Err[int]("sentinel"), sentinel), false)
+	assert.Equal(t, ContainsErr(Ok(1), sentinel), false)
+}
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, Compare(Ok(1), Ok(2)), -1)
+	assert.Equal(t, Compare(Ok(2), Ok(1)), 1)
+	assert.Equal(t, Compare(Ok(1), Ok(1)), 0)
+	assert.Equal(t, Compare(Ok(9), Err[int]("a")), -1)
+	assert.Equal(t, Compare(Err[int]("a"), Ok(9)), 1)
+	assert.Equal(t, Compare(Err[int]("a"), Err[int]("b")), 0)
+}
+
+func TestCompareSort(t *testing.T) {
+	rs := []Result[int]{Err[int]("x"), Ok(3), Err[int]("y"), Ok(1), Ok(2)}
+	slices.SortStableFunc(rs, Compare[int])
+	got := []string{}
+	for _, r := range rs {
+		got = append(got, r.String())
+	}
+	assert.DeepEqual(t, got, []string{"Ok(1)", "Ok(2)", "Ok(3)", "Err(x)", "Err(y)"})
+}
+
+func TestEqualsFunc(t *testing.T) {
+	errEq := func(a, b error) bool { return errors.Is(a, b) }
+	assert.Assert(t, EqualsFunc(Ok([]int{1, 2}), Ok([]int{1, 2}), slices.Equal, errEq))
+	assert.Assert(t, !EqualsFunc(Ok([]int{1, 2}), Ok([]int{2, 1}), slices.Equal, errEq))
+
+	boom := errors.New("boom")
+	assert.Assert(t, EqualsFunc(Err[[]int](fmt.Errorf("wrap: %w", boom)), Err[[]int](boom), slices.Equal, errEq))
+	assert.Assert(t, !EqualsFunc(Err[[]int]("boom"), Err[[]int](boom), slices.Equal, errEq))
+}
+
+func TestEqualsFuncCallsOnlyMatchingBranch(t *testing.T) {
+	valCalls, errCalls := 0, 0
+	valEq := func(a, b []int) bool { valCalls++; return true }
+	errEq := func(a, b error) bool { errCalls++; return true }
+
+	assert.Assert(t, !EqualsFunc(Ok([]int{1}), Err[[]int]("x"), valEq, errEq))
+	assert.Assert(t, !EqualsFunc(Err[[]int]("x"), Ok([]int{1}), valEq, errEq))
+	assert.Equal(t, valCalls+errCalls, 0)
+
+	EqualsFunc(Ok([]int{1}), Ok([]int{1}), valEq, errEq)
+	assert.Equal(t, valCalls, 1)
+	EqualsFunc(Err[[]int]("x"), Err[[]int]("y"), valEq, errEq)
+	assert.Equal(t, errCalls, 1)
+}
@@ -0,0 +1,68 @@
+package cement
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// FromContext returns Err(ctx.Err()) if ctx is already done and Ok(t)
+// otherwise.
+func FromContext[T any](ctx context.Context, t T) Result[T] {
+	if err := ctx.Err(); err != nil {
+		return Err[T](err)
+	}
+	return Ok(t)
+}
+
+// RunCtx runs f with ctx. If ctx is done once f returns, ctx.Err() is
+// reported in preference to whatever f returned.
+func RunCtx[T any](ctx context.Context, f func(context.Context) (T, error)) Result[T] {
+	t, err := f(ctx)
+	if cerr := ctx.Err(); cerr != nil {
+		return Err[T](cerr)
+	}
+	return From(t, err)
+}
+
+// WithTimeout runs f in a goroutine and returns
+// Err(context.DeadlineExceeded) if it has not finished within d. A panic in
+// f is recovered into an Err like Guard does. f can not be stopped from
+// outside: if it never returns its goroutine leaks. Prefer WithTimeoutCtx
+// for functions that can observe cancellation.
+func WithTimeout[T any](d time.Duration, f func() (T, error)) Result[T] {
+	return WithTimeoutCtx(context.Background(), d, func(context.Context) (T, error) {
+		return f()
+	})
+}
+
+// WithTimeoutCtx is like WithTimeout but hands f a context that is
+// canceled once d has passed or ctx is done.
+func WithTimeoutCtx[T any](ctx context.Context, d time.Duration, f func(context.Context) (T, error)) Result[T] {
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+	done := make(chan Result[T], 1)
+	go func() {
+		done <- Guard(func() (T, error) { return f(ctx) })
+	}()
+	select {
+	case r := <-done:
+		return r
+	case <-ctx.Done():
+		return Err[T](ctx.Err())
+	}
+}
+
+// isTimeout matches context.DeadlineExceeded and any error in the chain
+// with a Timeout() bool method reporting true, as net.Error has.
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var t interface{ Timeout() bool }
+	return errors.As(err, &t) && t.Timeout()
+}
+
+func isCanceled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
@@ -0,0 +1,130 @@
+package cement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, FromContext(context.Background(), 1).Ok(), 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, FromContext(ctx, 1).Err(), context.Canceled)
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	assert.Equal(t, FromContext(ctx, 1).Err(), context.DeadlineExceeded)
+}
+
+func TestRunCtx(t *testing.T) {
+	result := RunCtx(context.Background(), func(context.Context) (int, error) {
+		return 1, nil
+	})
+	assert.Equal(t, result.Ok(), 1)
+
+	sentinel := errors.New("sentinel")
+	result = RunCtx(context.Background(), func(context.Context) (int, error) {
+		return 0, sentinel
+	})
+	assert.Equal(t, result.Err(), sentinel)
+}
+
+func TestRunCtxPrefersContextError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	result := RunCtx(ctx, func(ctx context.Context) (int, error) {
+		cancel()
+		return 0, errors.New("aborted")
+	})
+	assert.Equal(t, result.Err(), context.Canceled)
+}
+
+func TestWithTimeoutInTime(t *testing.T) {
+	result := WithTimeout(time.Second, func() (int, error) { return 1, nil })
+	assert.Equal(t, result.Ok(), 1)
+
+	sentinel := errors.New("sentinel")
+	result = WithTimeout(time.Second, func() (int, error) { return 0, sentinel })
+	assert.Equal(t, result.Err(), sentinel)
+}
+
+func TestWithTimeoutTimesOut(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	result := WithTimeout(time.Millisecond, func() (int, error) {
+		<-release
+		return 1, nil
+	})
+	assert.Equal(t, result.Err(), context.DeadlineExceeded)
+}
+
+func TestWithTimeoutCtx(t *testing.T) {
+	stopped := make(chan error, 1)
+	result := WithTimeoutCtx(context.Background(), time.Millisecond, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		stopped <- ctx.Err()
+		return 0, ctx.Err()
+	})
+	assert.Equal(t, result.Err(), context.DeadlineExceeded)
+	assert.Equal(t, <-stopped, context.DeadlineExceeded)
+
+	result = WithTimeoutCtx(context.Background(), time.Second, func(ctx context.Context) (int, error) {
+		return 2, nil
+	})
+	assert.Equal(t, result.Ok(), 2)
+}
+
+type netTimeoutError struct{ timeout bool }
+
+func (e netTimeoutError) Error() string   { return "i/o timeout" }
+func (e netTimeoutError) Timeout() bool   { return e.timeout }
+func (e netTimeoutError) Temporary() bool { return false }
+
+var _ net.Error = netTimeoutError{}
+
+func TestIsErrTimeout(t *testing.T) {
+	assert.Equal(t, Err[int](context.DeadlineExceeded).IsErrTimeout(), true)
+	assert.Equal(t, Err[int](fmt.Errorf("dial: %w", netTimeoutError{timeout: true})).IsErrTimeout(), true)
+	assert.Equal(t, Err[int](netTimeoutError{timeout: false}).IsErrTimeout(), false)
+	assert.Equal(t, Err[int](context.Canceled).IsErrTimeout(), false)
+	assert.Equal(t, Ok(1).IsErrTimeout(), false)
+	assert.Equal(t, ErrValue[int](context.DeadlineExceeded).IsErrTimeout(), true)
+}
+
+func TestIsErrCanceled(t *testing.T) {
+	assert.Equal(t, Err[int](fmt.Errorf("op: %w", context.Canceled)).IsErrCanceled(), true)
+	assert.Equal(t, Err[int](context.DeadlineExceeded).IsErrCanceled(), false)
+	assert.Equal(t, Ok(1).IsErrCanceled(), false)
+	assert.Equal(t, OkValue(1).IsErrCanceled(), false)
+}
+
+func TestEnsureCtx(t *testing.T) {
+	live := context.Background()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	boom := errors.New("boom")
+
+	assert.Equal(t, Ok(1).EnsureCtx(live).Ok(), 1)
+	assert.Equal(t, Ok(1).EnsureCtx(canceled).Err(), context.Canceled)
+	assert.Equal(t, Err[int](boom).EnsureCtx(live).Err(), boom)
+	assert.Equal(t, Err[int](boom).EnsureCtx(canceled).Err(), boom)
+	assert.Equal(t, OkValue(1).EnsureCtx(canceled).Err(), context.Canceled)
+}
+
+func TestWithTimeoutCtxRecoversPanic(t *testing.T) {
+	r := WithTimeoutCtx(context.Background(), time.Second, func(context.Context) (int, error) {
+		panic("boom")
+	})
+	assert.Error(t, r.Err(), "boom")
+
+	r = WithTimeout(time.Second, func() (int, error) {
+		panic(errors.New("wrapped boom"))
+	})
+	assert.Error(t, r.Err(), "wrapped boom")
+}
@@ -0,0 +1,20 @@
+package cement
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// DebugEnabled switches Debug on. It is meant to be set once at startup or
+// in a test, not toggled while Results are being debugged concurrently.
+var DebugEnabled = false
+
+var debugOut io.Writer = os.Stderr
+
+func debug[T any](r Result[T], label string) Result[T] {
+	if DebugEnabled {
+		fmt.Fprintf(debugOut, "%s: %s\n", label, r.String())
+	}
+	return r
+}
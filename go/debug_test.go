@@ -0,0 +1,45 @@
+package cement
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func captureDebug(t *testing.T, enabled bool) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	prevEnabled, prevOut := DebugEnabled, debugOut
+	DebugEnabled, debugOut = enabled, &buf
+	t.Cleanup(func() { DebugEnabled, debugOut = prevEnabled, prevOut })
+	return &buf
+}
+
+func TestDebugEnabled(t *testing.T) {
+	buf := captureDebug(t, true)
+	r := Map(Ok(1).Debug("parsed"), func(v int) int { return v + 1 })
+	assert.Equal(t, r.Ok(), 2)
+	assert.Error(t, Err[int]("boom").Debug("load").Err(), "boom")
+	assert.Equal(t, OkValue(3).Debug("value").Ok(), 3)
+	assert.Equal(t, buf.String(), "parsed: Ok(1)\nload: Err(boom)\nvalue: Ok(3)\n")
+}
+
+func TestDebugDisabled(t *testing.T) {
+	buf := captureDebug(t, false)
+	assert.Equal(t, Ok(1).Debug("parsed").Ok(), 1)
+	assert.Error(t, Err[int]("boom").Debug("load").Err(), "boom")
+	assert.Equal(t, buf.Len(), 0)
+}
+
+func TestDebugWritesToStderr(t *testing.T) {
+	assert.Equal(t, debugOut, os.Stderr)
+}
+
+func BenchmarkDebugDisabled(b *testing.B) {
+	r := Ok(1)
+	for i := 0; i < b.N; i++ {
+		r = r.Debug("bench")
+	}
+}
@@ -0,0 +1,35 @@
+package cement
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrEnvUnset is wrapped by the Err of GetEnv when the variable is not set,
+// to tell a missing value apart from a malformed one.
+var ErrEnvUnset = errors.New("environment variable not set")
+
+// GetEnv reads the environment variable key and parses it with parse. An
+// unset variable is an Err wrapping ErrEnvUnset; a set but empty one is
+// handed to parse. A parse failure is an Err naming key and wrapping the
+// parse error.
+func GetEnv[T any](key string, parse func(string) (T, error)) Result[T] {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		return Err[T](fmt.Errorf("%w: %s", ErrEnvUnset, key))
+	}
+	t, err := parse(s)
+	if err != nil {
+		return Err[T](fmt.Errorf("env %s: %w", key, err))
+	}
+	return Ok(t)
+}
+
+// GetEnvOr is GetEnv falling back to Ok(def) only when key is unset; a
+// value that fails to parse is still an Err.
+func GetEnvOr[T any](key string, def T, parse func(string) (T, error)) Result[T] {
+	return GetEnv(key, parse).RecoverIf(func(err error) bool {
+		return errors.Is(err, ErrEnvUnset)
+	}, def)
+}
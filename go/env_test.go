@@ -0,0 +1,48 @@
+package cement
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+const testEnvKey = "CEMENT_TEST_PORT"
+
+func unsetEnv(t *testing.T, key string) {
+	t.Helper()
+	t.Setenv(key, "")
+	assert.NilError(t, os.Unsetenv(key))
+}
+
+func TestGetEnv(t *testing.T) {
+	unsetEnv(t, testEnvKey)
+	r := GetEnv(testEnvKey, strconv.Atoi)
+	assert.Assert(t, errors.Is(r.Err(), ErrEnvUnset))
+	assert.Error(t, r.Err(), "environment variable not set: CEMENT_TEST_PORT")
+
+	t.Setenv(testEnvKey, "8080")
+	assert.Equal(t, GetEnv(testEnvKey, strconv.Atoi).Ok(), 8080)
+
+	t.Setenv(testEnvKey, "http")
+	r = GetEnv(testEnvKey, strconv.Atoi)
+	var numErr *strconv.NumError
+	assert.Assert(t, errors.As(r.Err(), &numErr))
+	assert.Assert(t, !errors.Is(r.Err(), ErrEnvUnset))
+}
+
+func TestGetEnvOr(t *testing.T) {
+	unsetEnv(t, testEnvKey)
+	assert.Equal(t, GetEnvOr(testEnvKey, 80, strconv.Atoi).Ok(), 80)
+
+	t.Setenv(testEnvKey, "8080")
+	assert.Equal(t, GetEnvOr(testEnvKey, 80, strconv.Atoi).Ok(), 8080)
+
+	t.Setenv(testEnvKey, "http")
+	assert.Assert(t, GetEnvOr(testEnvKey, 80, strconv.Atoi).IsErr())
+
+	t.Setenv(testEnvKey, "")
+	assert.Assert(t, GetEnvOr(testEnvKey, 80, strconv.Atoi).IsErr())
+}
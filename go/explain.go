@@ -0,0 +1,29 @@
+package cement
+
+import (
+	"fmt"
+	"strings"
+)
+
+// explainChain writes err and everything it wraps, one link per line with
+// its dynamic type, indented by depth. Joined errors fan out as siblings.
+func explainChain(sb *strings.Builder, err error, depth int) {
+	indent := strings.Repeat("  ", depth)
+	fmt.Fprintf(sb, "\n%s%T: %s", indent, err, indentLines(fmt.Sprintf("%+v", err), indent+"  "))
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		if next := u.Unwrap(); next != nil {
+			explainChain(sb, next, depth+1)
+		}
+	case interface{ Unwrap() []error }:
+		for _, next := range u.Unwrap() {
+			explainChain(sb, next, depth+1)
+		}
+	}
+}
+
+// indentLines indents every line of s but the first, so multi-line values
+// and messages stay under their heading.
+func indentLines(s, indent string) string {
+	return strings.ReplaceAll(s, "\n", "\n"+indent)
+}
@@ -0,0 +1,39 @@
+package cement
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestExplainOk(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+	assert.Equal(t, Ok(point{X: 1, Y: 2}).Explain(), "Ok[cement.point]\n  {X:1 Y:2}")
+	assert.Equal(t, OkValue(3).Explain(), "Ok[int]\n  3")
+}
+
+func TestExplainErrChain(t *testing.T) {
+	inner := &testError{code: 7}
+	err := fmt.Errorf("load: %w", fmt.Errorf("parse: %w", inner))
+	assert.Equal(t, Err[int](err).Explain(), `Err[int]
+  *fmt.wrapError: load: parse: code 7
+    *fmt.wrapError: parse: code 7
+      *cement.testError: code 7`)
+}
+
+func TestExplainJoined(t *testing.T) {
+	err := errors.Join(errors.New("a"), errors.New("b"))
+	assert.Equal(t, Err[string](err).Explain(), `Err[string]
+  *errors.joinError: a
+    b
+    *errors.errorString: a
+    *errors.errorString: b`)
+}
+
+func TestExplainMultiLineValue(t *testing.T) {
+	assert.Equal(t, Ok("first\nsecond").Explain(), "Ok[string]\n  first\n  second")
+}
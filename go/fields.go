@@ -0,0 +1,39 @@
+package cement
+
+import (
+	"errors"
+	"maps"
+)
+
+// FieldError attaches structured fields to an error for loggers to pick up
+// with errors.As. It keeps the message of the error it wraps.
+type FieldError struct {
+	err    error
+	fields map[string]any
+}
+
+func (e *FieldError) Error() string {
+	return e.err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.err
+}
+
+// Fields returns a copy of the fields, including those of any FieldError
+// further down the chain.
+func (e *FieldError) Fields() map[string]any {
+	return maps.Clone(e.fields)
+}
+
+// withFields wraps err in a FieldError holding fields merged over the
+// fields already in err's chain, the new ones winning.
+func withFields(err error, fields map[string]any) error {
+	merged := map[string]any{}
+	var prev *FieldError
+	if errors.As(err, &prev) {
+		maps.Copy(merged, prev.fields)
+	}
+	maps.Copy(merged, fields)
+	return &FieldError{err: err, fields: merged}
+}
@@ -0,0 +1,36 @@
+package cement
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestWithFields(t *testing.T) {
+	sentinel := errors.New("load failed")
+	r := Err[int](sentinel).WithFields(map[string]any{"op": "load"})
+	assert.Error(t, r.Err(), "load failed")
+	assert.Assert(t, errors.Is(r.Err(), sentinel))
+
+	var fe *FieldError
+	assert.Assert(t, errors.As(r.Err(), &fe))
+	assert.DeepEqual(t, fe.Fields(), map[string]any{"op": "load"})
+}
+
+func TestWithFieldsMerges(t *testing.T) {
+	r := Err[int]("boom").
+		WithFields(map[string]any{"op": "load", "user": 1}).
+		WithFields(map[string]any{"user": 7, "attempt": 2})
+	var fe *FieldError
+	assert.Assert(t, errors.As(r.Err(), &fe))
+	assert.DeepEqual(t, fe.Fields(), map[string]any{"op": "load", "user": 7, "attempt": 2})
+
+	fe.Fields()["op"] = "changed"
+	assert.Equal(t, fe.Fields()["op"], "load")
+}
+
+func TestWithFieldsOk(t *testing.T) {
+	assert.Equal(t, Ok(1).WithFields(map[string]any{"op": "load"}).Ok(), 1)
+	assert.Equal(t, OkValue(1).WithFields(map[string]any{"op": "load"}).Ok(), 1)
+}
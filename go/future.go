@@ -0,0 +1,46 @@
+package cement
+
+import "context"
+
+// Future is a Result that is computed in the background.
+type Future[T any] struct {
+	done   chan struct{}
+	result Result[T]
+}
+
+// Async starts f in a new goroutine and returns a Future for its Result.
+// The goroutine always runs to completion and stores its Result, whether
+// or not anyone awaits it. A panic in f resolves the Future to an Err.
+func Async[T any](f func() Result[T]) *Future[T] {
+	fut := &Future[T]{done: make(chan struct{})}
+	go func() {
+		defer close(fut.done)
+		fut.result = Flatten(Try(f))
+	}()
+	return fut
+}
+
+// Await blocks until the Future resolves. Every call returns the same
+// Result.
+func (f *Future[T]) Await() Result[T] {
+	<-f.done
+	return f.result
+}
+
+// AwaitCtx is like Await but gives up with Err(ctx.Err()) once ctx is done.
+func (f *Future[T]) AwaitCtx(ctx context.Context) Result[T] {
+	select {
+	case <-f.done:
+		return f.result
+	case <-ctx.Done():
+		return Err[T](ctx.Err())
+	}
+}
+
+// Then returns a Future that awaits fut and, if it resolved to Ok, runs f
+// with the value. An Err is propagated without calling f.
+func Then[T, U any](fut *Future[T], f func(T) Result[U]) *Future[U] {
+	return Async(func() Result[U] {
+		return AndThen(fut.Await(), f)
+	})
+}
@@ -0,0 +1,67 @@
+package cement
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestAsyncAwait(t *testing.T) {
+	calls := 0
+	fut := Async(func() Result[int] {
+		calls++
+		return Ok(42)
+	})
+	assert.Equal(t, fut.Await().Ok(), 42)
+	assert.Equal(t, fut.Await().Ok(), 42)
+	assert.Equal(t, calls, 1)
+}
+
+func TestAsyncErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	fut := Async(func() Result[int] { return Err[int](sentinel) })
+	assert.Equal(t, fut.Await().Err(), sentinel)
+}
+
+func TestAsyncPanic(t *testing.T) {
+	fut := Async(func() Result[int] { panic("boom") })
+	assert.Equal(t, fut.Await().Err().Error(), "boom")
+}
+
+func TestAwaitCtxCanceled(t *testing.T) {
+	release := make(chan struct{})
+	fut := Async(func() Result[int] {
+		<-release
+		return Ok(1)
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	assert.Equal(t, fut.AwaitCtx(ctx).Err(), context.DeadlineExceeded)
+
+	close(release)
+	assert.Equal(t, fut.Await().Ok(), 1)
+	assert.Equal(t, fut.AwaitCtx(context.Background()).Ok(), 1)
+}
+
+func TestThen(t *testing.T) {
+	fut := Then(Async(func() Result[int] { return Ok(20) }), func(i int) Result[int] {
+		time.Sleep(time.Millisecond)
+		return Ok(i + 1)
+	})
+	fut = Then(fut, func(i int) Result[int] { return Ok(i * 2) })
+	assert.Equal(t, fut.Await().Ok(), 42)
+}
+
+func TestThenSkipsOnErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	called := false
+	fut := Then(Async(func() Result[int] { return Err[int](sentinel) }), func(i int) Result[string] {
+		called = true
+		return Ok("x")
+	})
+	assert.Equal(t, fut.Await().Err(), sentinel)
+	assert.Equal(t, called, false)
+}
@@ -0,0 +1,89 @@
+package cement
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+)
+
+// RegisterGob registers the variants of Result[T] and ResultValue[T] with
+// encoding/gob so a Result[T] held in an interface-typed field round-trips
+// to the right type.
+func RegisterGob[T any]() {
+	gob.Register(ResultOK[T]{})
+	gob.Register(ResultError[T]{})
+	gob.Register(ResultValue[T]{})
+}
+
+func (r ResultOK[T]) GobEncode() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(&r.t); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *ResultOK[T]) GobDecode(data []byte) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(&r.t)
+}
+
+// GobEncode writes only the error message. Decoding rebuilds it with
+// errors.New, so the concrete error type and its chain are lost.
+func (r ResultError[T]) GobEncode() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(r.t.Error()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *ResultError[T]) GobDecode(data []byte) error {
+	var msg string
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&msg); err != nil {
+		return err
+	}
+	r.t = errors.New(msg)
+	return nil
+}
+
+// GobEncode writes whether r is an Err, then the value or, as for
+// ResultError, only the error message.
+func (r ResultValue[T]) GobEncode() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	enc := gob.NewEncoder(buf)
+	if err := enc.Encode(r.err != nil); err != nil {
+		return nil, err
+	}
+	var err error
+	if r.err != nil {
+		err = enc.Encode(r.err.Error())
+	} else {
+		err = enc.Encode(&r.t)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *ResultValue[T]) GobDecode(data []byte) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+	var isErr bool
+	if err := dec.Decode(&isErr); err != nil {
+		return err
+	}
+	if !isErr {
+		var t T
+		if err := dec.Decode(&t); err != nil {
+			return err
+		}
+		*r = ResultValue[T]{t: t}
+		return nil
+	}
+	var msg string
+	if err := dec.Decode(&msg); err != nil {
+		return err
+	}
+	*r = ResultValue[T]{err: errors.New(msg)}
+	return nil
+}
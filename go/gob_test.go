@@ -0,0 +1,61 @@
+package cement
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+type gobPoint struct {
+	X, Y int
+	Name string
+}
+
+type gobEnvelope struct {
+	Result Result[gobPoint]
+}
+
+func gobRoundTrip[T any](t *testing.T, in T) T {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	assert.NilError(t, gob.NewEncoder(buf).Encode(in))
+	var out T
+	assert.NilError(t, gob.NewDecoder(buf).Decode(&out))
+	return out
+}
+
+func TestGobOk(t *testing.T) {
+	out := gobRoundTrip(t, ResultOK[gobPoint]{t: gobPoint{X: 1, Y: 2, Name: "p"}})
+	assert.Equal(t, out.Ok(), gobPoint{X: 1, Y: 2, Name: "p"})
+}
+
+func TestGobErr(t *testing.T) {
+	out := gobRoundTrip(t, ResultError[int]{t: &testError{code: 3}})
+	assert.Equal(t, out.Err().Error(), "code 3")
+}
+
+func TestGobInterfaceField(t *testing.T) {
+	RegisterGob[gobPoint]()
+
+	out := gobRoundTrip(t, gobEnvelope{Result: Ok(gobPoint{X: 1, Y: 2, Name: "p"})})
+	assert.Equal(t, out.Result.IsOk(), true)
+	assert.Equal(t, out.Result.Ok(), gobPoint{X: 1, Y: 2, Name: "p"})
+
+	out = gobRoundTrip(t, gobEnvelope{Result: Err[gobPoint]("boom")})
+	assert.Equal(t, out.Result.IsErr(), true)
+	assert.Equal(t, out.Result.Err().Error(), "boom")
+}
+
+func TestGobValue(t *testing.T) {
+	out := gobRoundTrip(t, OkValue(gobPoint{X: 1, Y: 2, Name: "p"}))
+	assert.Equal(t, out.Ok(), gobPoint{X: 1, Y: 2, Name: "p"})
+
+	out = gobRoundTrip(t, ErrValue[gobPoint](&testError{code: 3}))
+	assert.Equal(t, out.Err().Error(), "code 3")
+
+	RegisterGob[gobPoint]()
+	env := gobRoundTrip(t, gobEnvelope{Result: ErrValue[gobPoint]("boom")})
+	assert.Equal(t, env.Result.Err().Error(), "boom")
+}
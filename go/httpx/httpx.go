@@ -0,0 +1,55 @@
+// Package httpx turns Result-returning business logic into net/http
+// handlers.
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	cement "github.com/mabels/cement/go"
+)
+
+// StatusClientClosedRequest is the non-standard status nginx reports when
+// the client went away before the response was written.
+const StatusClientClosedRequest = 499
+
+// Handler runs f for each request and renders an Ok with onOk or an Err
+// with onErr. A nil onErr falls back to WriteError.
+func Handler[T any](f func(*http.Request) cement.Result[T], onOk func(http.ResponseWriter, T), onErr func(http.ResponseWriter, error)) http.HandlerFunc {
+	if onErr == nil {
+		onErr = WriteError
+	}
+	return func(w http.ResponseWriter, req *http.Request) {
+		r := f(req)
+		if r.IsErr() {
+			onErr(w, r.Err())
+			return
+		}
+		onOk(w, r.Ok())
+	}
+}
+
+// StatusFor maps err to a status code: context.Canceled to 499,
+// context.DeadlineExceeded to 504 and anything else to 500.
+func StatusFor(err error) int {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return StatusClientClosedRequest
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// WriteError is the default error renderer: it replies with StatusFor(err)
+// and the standard text for that status, not leaking err to the client.
+func WriteError(w http.ResponseWriter, err error) {
+	code := StatusFor(err)
+	text := http.StatusText(code)
+	if text == "" {
+		text = "Client Closed Request"
+	}
+	http.Error(w, text, code)
+}
@@ -0,0 +1,66 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cement "github.com/mabels/cement/go"
+	"gotest.tools/v3/assert"
+)
+
+func writeName(w http.ResponseWriter, name string) {
+	fmt.Fprintf(w, "hello %s", name)
+}
+
+func serve(h http.Handler, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func TestHandlerOk(t *testing.T) {
+	h := Handler(func(req *http.Request) cement.Result[string] {
+		return cement.Ok(req.URL.Query().Get("name"))
+	}, writeName, nil)
+	rec := serve(h, "/?name=cement")
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, rec.Body.String(), "hello cement")
+}
+
+func TestHandlerDefaultErrors(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		code int
+	}{
+		{context.Canceled, StatusClientClosedRequest},
+		{fmt.Errorf("query: %w", context.DeadlineExceeded), http.StatusGatewayTimeout},
+		{errors.New("boom"), http.StatusInternalServerError},
+	} {
+		h := Handler(func(*http.Request) cement.Result[string] {
+			return cement.Err[string](tc.err)
+		}, writeName, nil)
+		rec := serve(h, "/")
+		assert.Equal(t, rec.Code, tc.code, tc.err.Error())
+		assert.Assert(t, rec.Body.String() != "", tc.err.Error())
+	}
+}
+
+func TestHandlerCustomOnErr(t *testing.T) {
+	notFound := errors.New("not found")
+	h := Handler(func(*http.Request) cement.Result[string] {
+		return cement.Err[string](notFound)
+	}, writeName, func(w http.ResponseWriter, err error) {
+		if errors.Is(err, notFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		WriteError(w, err)
+	})
+	rec := serve(h, "/")
+	assert.Equal(t, rec.Code, http.StatusNotFound)
+	assert.Equal(t, rec.Body.String(), "not found\n")
+}
@@ -0,0 +1,26 @@
+// Package iox wraps common io and os calls so they return cement Results.
+// Errors are passed through unchanged, so os.IsNotExist and errors.Is keep
+// working on them.
+package iox
+
+import (
+	"io"
+	"os"
+
+	cement "github.com/mabels/cement/go"
+)
+
+// ReadFile is os.ReadFile returning a Result.
+func ReadFile(path string) cement.Result[[]byte] {
+	return cement.From(os.ReadFile(path))
+}
+
+// Open is os.Open returning a Result. The caller closes the file.
+func Open(path string) cement.Result[*os.File] {
+	return cement.From(os.Open(path))
+}
+
+// ReadAll is io.ReadAll returning a Result.
+func ReadAll(r io.Reader) cement.Result[[]byte] {
+	return cement.From(io.ReadAll(r))
+}
@@ -0,0 +1,53 @@
+package iox
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.txt")
+	assert.NilError(t, os.WriteFile(path, []byte("hello"), 0o600))
+
+	r := ReadFile(path)
+	assert.Assert(t, r.IsOk())
+	assert.Equal(t, string(r.Ok()), "hello")
+
+	missing := ReadFile(filepath.Join(t.TempDir(), "missing"))
+	assert.Assert(t, missing.IsErr())
+	assert.Assert(t, os.IsNotExist(missing.Err()))
+	assert.Assert(t, errors.Is(missing.Err(), fs.ErrNotExist))
+}
+
+func TestOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.txt")
+	assert.NilError(t, os.WriteFile(path, []byte("hello"), 0o600))
+
+	r := Open(path)
+	assert.Assert(t, r.IsOk())
+	f := r.Ok()
+	defer f.Close()
+	b := ReadAll(f)
+	assert.Assert(t, b.IsOk())
+	assert.Equal(t, string(b.Ok()), "hello")
+
+	missing := Open(filepath.Join(t.TempDir(), "missing"))
+	assert.Assert(t, errors.Is(missing.Err(), fs.ErrNotExist))
+}
+
+func TestReadAll(t *testing.T) {
+	r := ReadAll(strings.NewReader("abc"))
+	assert.Equal(t, string(r.Ok()), "abc")
+
+	errBoom := errors.New("boom")
+	e := ReadAll(iotest.ErrReader(errBoom))
+	assert.Assert(t, e.IsErr())
+	assert.Equal(t, e.Err(), errBoom)
+}
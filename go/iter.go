@@ -0,0 +1,50 @@
+package cement
+
+import (
+	"bufio"
+	"io"
+	"iter"
+)
+
+// FoldSeq folds the Ok values of seq into acc with f. It stops pulling
+// from seq at the first Err and returns it.
+func FoldSeq[T, A any](seq iter.Seq[Result[T]], init A, f func(A, T) A) Result[A] {
+	acc := init
+	for r := range seq {
+		if r.IsErr() {
+			return Err[A](r.Err())
+		}
+		acc = f(acc, r.Ok())
+	}
+	return Ok(acc)
+}
+
+// SeqToResult drains a fallible iterator into a slice. It stops pulling
+// from seq at the first non-nil error and returns it.
+func SeqToResult[T any](seq iter.Seq2[T, error]) Result[[]T] {
+	out := []T{}
+	for v, err := range seq {
+		if err != nil {
+			return Err[[]T](err)
+		}
+		out = append(out, v)
+	}
+	return Ok(out)
+}
+
+// ScanLines lazily parses r line by line with parse. A read error, including
+// bufio.ErrTooLong for a line that does not fit the scanner buffer, is
+// yielded as a final Err instead of ending the sequence silently.
+func ScanLines[T any](r io.Reader, parse func(string) Result[T]) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		sc := bufio.NewScanner(r)
+		for sc.Scan() {
+			if !yield(parse(sc.Text())) {
+				return
+			}
+		}
+		if err := sc.Err(); err != nil {
+			yield(Err[T](err))
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package cement
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"gotest.tools/v3/assert"
+)
+
+func add(a, b int) int {
+	return a + b
+}
+
+func TestFoldSeq(t *testing.T) {
+	seq := slices.Values([]Result[int]{Ok(1), Ok(2), Ok(3)})
+	assert.Equal(t, FoldSeq(seq, 10, add).Ok(), 16)
+	assert.Equal(t, FoldSeq(slices.Values([]Result[int]{}), 10, add).Ok(), 10)
+}
+
+func TestFoldSeqStopsAtErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	seq := func(yield func(Result[int]) bool) {
+		if !yield(Ok(1)) {
+			return
+		}
+		if !yield(Err[int](sentinel)) {
+			return
+		}
+		panic("seq consumed past the error")
+	}
+	assert.Equal(t, FoldSeq(seq, 0, add).Err(), sentinel)
+}
+
+func TestSeqToResult(t *testing.T) {
+	seq := func(yield func(int, error) bool) {
+		for i := range 3 {
+			if !yield(i, nil) {
+				return
+			}
+		}
+	}
+	assert.DeepEqual(t, SeqToResult(seq).Ok(), []int{0, 1, 2})
+
+	empty := SeqToResult(func(yield func(int, error) bool) {}).Ok()
+	assert.Assert(t, empty != nil)
+	assert.Equal(t, len(empty), 0)
+}
+
+func TestSeqToResultStopsAtErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	seq := func(yield func(int, error) bool) {
+		if !yield(1, nil) {
+			return
+		}
+		if !yield(0, sentinel) {
+			return
+		}
+		panic("seq consumed past the error")
+	}
+	assert.Equal(t, SeqToResult(seq).Err(), sentinel)
+}
+
+func TestScanLines(t *testing.T) {
+	readErr := errors.New("disk on fire")
+	r := io.MultiReader(strings.NewReader("1\nx\n3\n"), iotest.ErrReader(readErr))
+	var oks []int
+	var errs []error
+	for res := range ScanLines(r, parseInt) {
+		if res.IsErr() {
+			errs = append(errs, res.Err())
+			continue
+		}
+		oks = append(oks, res.Ok())
+	}
+	assert.DeepEqual(t, oks, []int{1, 3})
+	assert.Equal(t, len(errs), 2)
+	var numErr *strconv.NumError
+	assert.Assert(t, errors.As(errs[0], &numErr))
+	assert.Equal(t, errs[1], readErr)
+}
+
+func TestScanLinesTooLong(t *testing.T) {
+	long := strings.Repeat("9", bufio.MaxScanTokenSize+1)
+	rs := slices.Collect(ScanLines(strings.NewReader("1\n"+long+"\n2\n"), parseInt))
+	assert.Equal(t, len(rs), 2)
+	assert.Equal(t, rs[0].Ok(), 1)
+	assert.Equal(t, rs[1].Err(), bufio.ErrTooLong)
+}
+
+func TestScanLinesFold(t *testing.T) {
+	assert.Equal(t, FoldSeq(ScanLines(strings.NewReader("1\n2\n3"), parseInt), 0, add).Ok(), 6)
+}
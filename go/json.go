@@ -0,0 +1,103 @@
+package cement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MarshalJSON encodes an Ok as {"ok": <value>}. The Result interface does
+// not carry the json.Marshaler methods, but encoding/json dispatches on the
+// dynamic type, so a Result[T] held in a struct field or passed to
+// json.Marshal encodes through the concrete variant.
+func (r ResultOK[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Ok T `json:"ok"`
+	}{Ok: r.t})
+}
+
+// MarshalJSON encodes an Err as {"error": "<message>"}.
+func (r ResultError[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Error string `json:"error"`
+	}{Error: r.t.Error()})
+}
+
+// UnmarshalJSON decodes the {"ok": ...} / {"error": ...} shape written by
+// MarshalJSON. Malformed input, including an object with both or neither
+// key, is reported as an Err rather than a panic.
+func UnmarshalJSON[T any](data []byte) Result[T] {
+	r, err := unmarshalJSON[T](data)
+	if err != nil {
+		return Err[T](err)
+	}
+	return r
+}
+
+// unmarshalJSON keeps malformed input, the error, apart from a well-formed
+// {"error": ...}, which is a decoded Err.
+func unmarshalJSON[T any](data []byte) (Result[T], error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("Result unmarshal: %w", err)
+	}
+	okData, hasOk := obj["ok"]
+	errData, hasErr := obj["error"]
+	switch {
+	case hasOk && hasErr:
+		return nil, errors.New("Result unmarshal: both ok and error present")
+	case hasOk:
+		var t T
+		if err := json.Unmarshal(okData, &t); err != nil {
+			return nil, fmt.Errorf("Result unmarshal ok: %w", err)
+		}
+		return Ok(t), nil
+	case hasErr:
+		var msg string
+		if err := json.Unmarshal(errData, &msg); err != nil {
+			return nil, fmt.Errorf("Result unmarshal error: %w", err)
+		}
+		return Err[T](errors.New(msg)), nil
+	default:
+		return nil, errors.New("Result unmarshal: neither ok nor error present")
+	}
+}
+
+// MarshalJSON encodes a ResultValue like the interface variants, so JSON
+// output does not depend on which form a Result is held in.
+func (r ResultValue[T]) MarshalJSON() ([]byte, error) {
+	return r.Box().(json.Marshaler).MarshalJSON()
+}
+
+// UnmarshalJSON decodes the MarshalJSON shape in place. Malformed input is
+// returned as the error; an {"error": ...} object is stored in r as an Err.
+func (r *ResultValue[T]) UnmarshalJSON(data []byte) error {
+	res, err := unmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	*r = Unbox(res)
+	return nil
+}
+
+// DecodeJSON unmarshals data into a fresh T. Unlike UnmarshalJSON it
+// decodes plain data, not the Result wrapper; the json error is returned
+// as is.
+func DecodeJSON[T any](data []byte) Result[T] {
+	var t T
+	if err := json.Unmarshal(data, &t); err != nil {
+		return Err[T](err)
+	}
+	return Ok(t)
+}
+
+// EncodeJSON is json.Marshal returning a Result.
+func EncodeJSON[T any](v T) Result[[]byte] {
+	return From(json.Marshal(v))
+}
+
+// RoundTripJSON encodes v and decodes it into a fresh T, for property and
+// fuzz tests asserting that the JSON encoding of T is lossless.
+func RoundTripJSON[T any](v T) Result[T] {
+	return AndThen(EncodeJSON(v), DecodeJSON[T])
+}
@@ -0,0 +1,151 @@
+package cement
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMarshalJSONOk(t *testing.T) {
+	data, err := json.Marshal(Ok([]int{1, 2, 3}))
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"ok":[1,2,3]}`)
+}
+
+func TestMarshalJSONErr(t *testing.T) {
+	data, err := json.Marshal(Err[int]("boom"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"error":"boom"}`)
+}
+
+func TestMarshalJSONField(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Result Result[string] `json:"result"`
+	}{Result: Ok("x")})
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"result":{"ok":"x"}}`)
+}
+
+func TestUnmarshalJSONOk(t *testing.T) {
+	result := UnmarshalJSON[[]int]([]byte(`{"ok":[1,2,3]}`))
+	assert.Equal(t, result.IsOk(), true)
+	assert.DeepEqual(t, result.Ok(), []int{1, 2, 3})
+}
+
+func TestUnmarshalJSONErr(t *testing.T) {
+	result := UnmarshalJSON[int]([]byte(`{"error":"boom"}`))
+	assert.Equal(t, result.IsErr(), true)
+	assert.Equal(t, result.Err().Error(), "boom")
+}
+
+func TestUnmarshalJSONMalformed(t *testing.T) {
+	for _, data := range []string{
+		`{"ok":1,"error":"boom"}`,
+		`{}`,
+		`[]`,
+		`{"ok":"notanint"}`,
+		`{"error":1}`,
+		`{`,
+	} {
+		result := UnmarshalJSON[int]([]byte(data))
+		assert.Equal(t, result.IsErr(), true, data)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	type point struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+	data, err := json.Marshal(Ok(point{X: 1, Y: 2}))
+	assert.NilError(t, err)
+	assert.Equal(t, UnmarshalJSON[point](data).Ok(), point{X: 1, Y: 2})
+
+	data, err = json.Marshal(Err[point]("boom"))
+	assert.NilError(t, err)
+	assert.Equal(t, UnmarshalJSON[point](data).Err().Error(), "boom")
+}
+
+func TestDecodeJSON(t *testing.T) {
+	type point struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+	assert.Equal(t, DecodeJSON[point]([]byte(`{"x":1,"y":2}`)).Ok(), point{X: 1, Y: 2})
+
+	result := DecodeJSON[point]([]byte(`{"x":`))
+	assert.Equal(t, result.IsErr(), true)
+	var syntaxErr *json.SyntaxError
+	assert.Assert(t, errors.As(result.Err(), &syntaxErr))
+
+	assert.Equal(t, DecodeJSON[int]([]byte(`"one"`)).IsErr(), true)
+}
+
+func TestEncodeJSON(t *testing.T) {
+	assert.Equal(t, string(EncodeJSON(map[string]int{"a": 1}).Ok()), `{"a":1}`)
+	assert.Equal(t, EncodeJSON(make(chan int)).IsErr(), true)
+}
+
+func TestRoundTripJSON(t *testing.T) {
+	type inner struct {
+		Tags []string `json:"tags"`
+	}
+	type outer struct {
+		Name  string         `json:"name"`
+		Inner inner          `json:"inner"`
+		Attrs map[string]int `json:"attrs"`
+	}
+	v := outer{Name: "n", Inner: inner{Tags: []string{"a", "b"}}, Attrs: map[string]int{"x": 1}}
+	assert.DeepEqual(t, RoundTripJSON(v).Unwrap(), v)
+}
+
+func TestRoundTripJSONMarshalErr(t *testing.T) {
+	type withChan struct {
+		C chan int
+	}
+	result := RoundTripJSON(withChan{C: make(chan int)})
+	assert.Equal(t, result.IsErr(), true)
+	var typeErr *json.UnsupportedTypeError
+	assert.Assert(t, errors.As(result.Err(), &typeErr))
+}
+
+type oneWay struct{ V int }
+
+func (oneWay) UnmarshalJSON([]byte) error {
+	return errors.New("oneWay cannot be decoded")
+}
+
+func TestRoundTripJSONUnmarshalErr(t *testing.T) {
+	assert.Error(t, RoundTripJSON(oneWay{V: 1}).Err(), "oneWay cannot be decoded")
+}
+
+func TestJSONValue(t *testing.T) {
+	data, err := json.Marshal(OkValue(1))
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"ok":1}`)
+
+	data, err = json.Marshal(ErrValue[int]("boom"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"error":"boom"}`)
+
+	type envelope struct {
+		Result Result[int] `json:"result"`
+	}
+	data, err = json.Marshal(envelope{Result: OkValue(2)})
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"result":{"ok":2}}`)
+}
+
+func TestJSONValueUnmarshal(t *testing.T) {
+	var v struct {
+		A ResultValue[int] `json:"a"`
+		B ResultValue[int] `json:"b"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(`{"a":{"ok":1},"b":{"error":"boom"}}`), &v))
+	assert.Equal(t, v.A.Ok(), 1)
+	assert.Error(t, v.B.Err(), "boom")
+
+	assert.Assert(t, json.Unmarshal([]byte(`{"a":{}}`), &v) != nil)
+}
@@ -0,0 +1,31 @@
+package cement
+
+import "sync"
+
+type lazyState[T any] struct {
+	once   sync.Once
+	f      func() Result[T]
+	result Result[T]
+}
+
+// LazyResult defers a computation until Force is first called. Copies of a
+// LazyResult share the same memoized outcome.
+type LazyResult[T any] struct {
+	state *lazyState[T]
+}
+
+func Lazy[T any](f func() Result[T]) LazyResult[T] {
+	return LazyResult[T]{state: &lazyState[T]{f: f}}
+}
+
+// Force runs the computation on the first call and returns the cached
+// Result afterwards. A panic in the computation is recovered into an Err
+// like Try does, and that Err is what every call returns. It is safe for
+// concurrent use.
+func (l LazyResult[T]) Force() Result[T] {
+	l.state.once.Do(func() {
+		l.state.result = Flatten(Try(l.state.f))
+		l.state.f = nil
+	})
+	return l.state.result
+}
@@ -0,0 +1,61 @@
+package cement
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLazyDefers(t *testing.T) {
+	calls := 0
+	l := Lazy(func() Result[int] {
+		calls++
+		return Ok(42)
+	})
+	assert.Equal(t, calls, 0)
+	assert.Equal(t, l.Force().Ok(), 42)
+	assert.Equal(t, l.Force().Ok(), 42)
+	assert.Equal(t, calls, 1)
+}
+
+func TestLazyCachesErr(t *testing.T) {
+	calls := 0
+	l := Lazy(func() Result[int] {
+		calls++
+		return Err[int]("boom")
+	})
+	assert.Equal(t, l.Force().Err().Error(), "boom")
+	assert.Equal(t, l.Force().Err().Error(), "boom")
+	assert.Equal(t, calls, 1)
+}
+
+func TestLazyConcurrentForce(t *testing.T) {
+	var calls atomic.Int32
+	l := Lazy(func() Result[int] {
+		calls.Add(1)
+		return Ok(1)
+	})
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, l.Force().Ok(), 1)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, calls.Load(), int32(1))
+}
+
+func TestLazyPanic(t *testing.T) {
+	calls := 0
+	l := Lazy(func() Result[int] {
+		calls++
+		panic("boom")
+	})
+	assert.Error(t, l.Force().Err(), "boom")
+	assert.Error(t, l.Force().Err(), "boom")
+	assert.Equal(t, calls, 1)
+}
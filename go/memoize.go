@@ -0,0 +1,123 @@
+package cement
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var errMemoAborted = errors.New("memoized function did not return")
+
+// Clock is the time source of MemoizeTTLClock, so tests can advance time
+// instead of sleeping.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+type memoCall[V any] struct {
+	done   chan struct{}
+	result Result[V]
+}
+
+type memoEntry[V any] struct {
+	result  Result[V]
+	expires time.Time
+}
+
+type memo[K comparable, V any] struct {
+	mu        sync.Mutex
+	f         func(K) Result[V]
+	cacheErrs bool
+	ttl       time.Duration
+	clock     Clock
+	cache     map[K]memoEntry[V]
+	inflight  map[K]*memoCall[V]
+}
+
+func (m *memo[K, V]) get(k K) Result[V] {
+	m.mu.Lock()
+	if e, ok := m.cache[k]; ok {
+		if m.ttl <= 0 || m.clock.Now().Before(e.expires) {
+			m.mu.Unlock()
+			return e.result
+		}
+		delete(m.cache, k)
+	}
+	if c, ok := m.inflight[k]; ok {
+		m.mu.Unlock()
+		<-c.done
+		return c.result
+	}
+	c := &memoCall[V]{done: make(chan struct{})}
+	m.inflight[k] = c
+	m.mu.Unlock()
+
+	defer m.settle(k, c)
+	c.result = Flatten(Try(func() Result[V] { return m.f(k) }))
+	return c.result
+}
+
+// settle publishes the outcome of c to the cache and its waiters. It runs
+// deferred so that even if f never returns, as with runtime.Goexit, the
+// waiters are released with an Err instead of blocking forever.
+func (m *memo[K, V]) settle(k K, c *memoCall[V]) {
+	if c.result == nil {
+		c.result = Err[V](errMemoAborted)
+	}
+	m.mu.Lock()
+	delete(m.inflight, k)
+	if c.result.IsOk() || m.cacheErrs {
+		e := memoEntry[V]{result: c.result}
+		if m.ttl > 0 {
+			e.expires = m.clock.Now().Add(m.ttl)
+		}
+		m.cache[k] = e
+	}
+	m.mu.Unlock()
+	close(c.done)
+}
+
+func newMemo[K comparable, V any](f func(K) Result[V], cacheErrs bool) *memo[K, V] {
+	return &memo[K, V]{
+		f:         f,
+		cacheErrs: cacheErrs,
+		clock:     systemClock{},
+		cache:     map[K]memoEntry[V]{},
+		inflight:  map[K]*memoCall[V]{},
+	}
+}
+
+// Memoize caches the Ok results of f per key. Errors are not cached, so
+// the next call for that key runs f again. Concurrent calls for a key that
+// is being computed wait for that computation instead of running f again.
+// A panic in f is recovered into an Err like Try does, for the caller and
+// any waiters alike.
+func Memoize[K comparable, V any](f func(K) Result[V]) func(K) Result[V] {
+	return newMemo(f, false).get
+}
+
+// MemoizeWithErrors is like Memoize but caches errors too.
+func MemoizeWithErrors[K comparable, V any](f func(K) Result[V]) func(K) Result[V] {
+	return newMemo(f, true).get
+}
+
+// MemoizeTTL is like Memoize but an Ok result is only served from the
+// cache for ttl after it was computed; the first call after that runs f
+// again.
+func MemoizeTTL[K comparable, V any](ttl time.Duration, f func(K) Result[V]) func(K) Result[V] {
+	return MemoizeTTLClock(systemClock{}, ttl, f)
+}
+
+// MemoizeTTLClock is MemoizeTTL measuring expiry with clock.
+func MemoizeTTLClock[K comparable, V any](clock Clock, ttl time.Duration, f func(K) Result[V]) func(K) Result[V] {
+	m := newMemo(f, false)
+	m.ttl = ttl
+	m.clock = clock
+	return m.get
+}
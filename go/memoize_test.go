@@ -0,0 +1,184 @@
+package cement
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMemoizeCachesOk(t *testing.T) {
+	calls := map[string]int{}
+	get := Memoize(func(k string) Result[int] {
+		calls[k]++
+		return Ok(len(k))
+	})
+	assert.Equal(t, get("abc").Ok(), 3)
+	assert.Equal(t, get("abc").Ok(), 3)
+	assert.Equal(t, get("de").Ok(), 2)
+	assert.DeepEqual(t, calls, map[string]int{"abc": 1, "de": 1})
+}
+
+func TestMemoizeRetriesErr(t *testing.T) {
+	calls := 0
+	get := Memoize(func(k string) Result[int] {
+		calls++
+		if calls == 1 {
+			return Err[int]("transient")
+		}
+		return Ok(calls)
+	})
+	assert.Equal(t, get("a").Err().Error(), "transient")
+	assert.Equal(t, get("a").Ok(), 2)
+	assert.Equal(t, get("a").Ok(), 2)
+	assert.Equal(t, calls, 2)
+}
+
+func TestMemoizeWithErrors(t *testing.T) {
+	calls := 0
+	get := MemoizeWithErrors(func(k string) Result[int] {
+		calls++
+		return Err[int]("permanent")
+	})
+	assert.Equal(t, get("a").Err().Error(), "permanent")
+	assert.Equal(t, get("a").Err().Error(), "permanent")
+	assert.Equal(t, calls, 1)
+}
+
+func TestMemoizeCoalesces(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	get := Memoize(func(k string) Result[int] {
+		calls.Add(1)
+		<-release
+		return Ok(1)
+	})
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, get("a").Ok(), 1)
+		}()
+	}
+	for calls.Load() == 0 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+	assert.Equal(t, calls.Load(), int32(1))
+}
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestMemoizeTTLExpires(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	calls := 0
+	get := MemoizeTTLClock(clock, time.Minute, func(k string) Result[int] {
+		calls++
+		return Ok(calls)
+	})
+	assert.Equal(t, get("a").Ok(), 1)
+	clock.Advance(59 * time.Second)
+	assert.Equal(t, get("a").Ok(), 1)
+	assert.Equal(t, calls, 1)
+
+	clock.Advance(time.Second)
+	assert.Equal(t, get("a").Ok(), 2)
+	assert.Equal(t, get("a").Ok(), 2)
+	assert.Equal(t, calls, 2)
+}
+
+func TestMemoizeTTLRetriesErr(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	calls := 0
+	get := MemoizeTTLClock(clock, time.Minute, func(k string) Result[int] {
+		calls++
+		return Err[int]("boom")
+	})
+	get("a")
+	get("a")
+	assert.Equal(t, calls, 2)
+}
+
+func TestMemoizeTTLCoalesces(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	get := MemoizeTTL(time.Hour, func(k string) Result[int] {
+		calls.Add(1)
+		<-release
+		return Ok(1)
+	})
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, get("a").Ok(), 1)
+		}()
+	}
+	for calls.Load() == 0 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+	assert.Equal(t, calls.Load(), int32(1))
+}
+
+func TestMemoizeRecoversPanic(t *testing.T) {
+	calls := 0
+	get := Memoize(func(k int) Result[int] {
+		calls++
+		if calls == 1 {
+			panic("first call explodes")
+		}
+		return Ok(k * 10)
+	})
+	assert.Error(t, get(1).Err(), "first call explodes")
+	assert.Equal(t, get(1).Ok(), 10)
+	assert.Equal(t, get(1).Ok(), 10)
+	assert.Equal(t, calls, 2)
+}
+
+func TestMemoizePanicReleasesWaiters(t *testing.T) {
+	started := make(chan struct{})
+	var once sync.Once
+	release := make(chan struct{})
+	get := MemoizeTTL(time.Hour, func(k int) Result[int] {
+		once.Do(func() { close(started) })
+		<-release
+		panic("boom")
+	})
+	first := make(chan Result[int])
+	go func() { first <- get(1) }()
+	<-started
+	waiter := make(chan Result[int])
+	go func() { waiter <- get(1) }()
+	runtime.Gosched()
+	close(release)
+	assert.Error(t, (<-first).Err(), "boom")
+	select {
+	case r := <-waiter:
+		assert.Assert(t, r.IsErr())
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiter blocked after panic in f")
+	}
+}
@@ -0,0 +1,71 @@
+package cement
+
+// metaEntry is an immutable list of metadata, newest entry first. Results
+// share it by pointer, so WithMeta never changes an existing Result and
+// the variants stay comparable.
+type metaEntry struct {
+	key   string
+	value any
+	next  *metaEntry
+}
+
+func (m *metaEntry) lookup(key string) (any, bool) {
+	for ; m != nil; m = m.next {
+		if m.key == key {
+			return m.value, true
+		}
+	}
+	return nil, false
+}
+
+// mergeMeta puts the entries of inner in front of outer, so inner wins for
+// keys present in both.
+func mergeMeta(inner, outer *metaEntry) *metaEntry {
+	if inner == nil {
+		return outer
+	}
+	if outer == nil {
+		return inner
+	}
+	return &metaEntry{key: inner.key, value: inner.value, next: mergeMeta(inner.next, outer)}
+}
+
+func metaOf[T any](r Result[T]) *metaEntry {
+	switch v := r.(type) {
+	case ResultOK[T]:
+		return v.meta
+	case ResultError[T]:
+		return v.meta
+	case ResultValue[T]:
+		return v.meta
+	default:
+		return nil
+	}
+}
+
+// withMeta adds m behind the metadata r already carries.
+func withMeta[T any](r Result[T], m *metaEntry) Result[T] {
+	switch v := r.(type) {
+	case ResultOK[T]:
+		v.meta = mergeMeta(v.meta, m)
+		return v
+	case ResultError[T]:
+		v.meta = mergeMeta(v.meta, m)
+		return v
+	case ResultValue[T]:
+		v.meta = mergeMeta(v.meta, m)
+		return v
+	default:
+		return r
+	}
+}
+
+func okMeta[T any](t T, m *metaEntry) Result[T] {
+	return ResultOK[T]{t: t, meta: m}
+}
+
+func errMeta[T any](err error, m *metaEntry) Result[T] {
+	res := Err[T](err).(ResultError[T])
+	res.meta = m
+	return res
+}
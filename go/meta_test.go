@@ -0,0 +1,69 @@
+package cement
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMetaDefault(t *testing.T) {
+	_, ok := Ok(1).Meta("request-id")
+	assert.Equal(t, ok, false)
+	_, ok = Err[int]("xxx").Meta("request-id")
+	assert.Equal(t, ok, false)
+}
+
+func TestWithMeta(t *testing.T) {
+	base := Ok(1)
+	r := base.WithMeta("request-id", "abc").WithMeta("user", 7)
+	v, ok := r.Meta("request-id")
+	assert.Equal(t, ok, true)
+	assert.Equal(t, v, "abc")
+	v, _ = r.Meta("user")
+	assert.Equal(t, v, 7)
+
+	v, _ = r.WithMeta("user", 8).Meta("user")
+	assert.Equal(t, v, 8)
+	v, _ = r.Meta("user")
+	assert.Equal(t, v, 7)
+	_, ok = base.Meta("request-id")
+	assert.Equal(t, ok, false)
+
+	e := Err[int]("xxx").WithMeta("request-id", "def")
+	v, _ = e.Meta("request-id")
+	assert.Equal(t, v, "def")
+}
+
+func TestMetaThroughMapAndThen(t *testing.T) {
+	r := Ok(1).WithMeta("request-id", "abc")
+	s := AndThen(Map(r, strconv.Itoa), func(s string) Result[string] {
+		return Ok(s+"!").WithMeta("step", "exclaim")
+	})
+	assert.Equal(t, s.Ok(), "1!")
+	v, _ := s.Meta("request-id")
+	assert.Equal(t, v, "abc")
+	v, _ = s.Meta("step")
+	assert.Equal(t, v, "exclaim")
+
+	sentinel := errors.New("sentinel")
+	failed := AndThen(r.Map(func(i int) int { return i + 1 }), func(int) Result[int] {
+		return Err[int](sentinel)
+	}).WrapErr("step")
+	assert.Assert(t, errors.Is(failed.Err(), sentinel))
+	v, _ = failed.Meta("request-id")
+	assert.Equal(t, v, "abc")
+
+	recovered := Err[int](sentinel).WithMeta("request-id", "abc").OrElse(func(error) Result[int] {
+		return Ok(0)
+	})
+	v, _ = recovered.Meta("request-id")
+	assert.Equal(t, v, "abc")
+}
+
+func TestMetaKeepsResultsComparable(t *testing.T) {
+	assert.Equal(t, Ok(1), Ok(1))
+	r := Ok(1).WithMeta("k", "v")
+	assert.Equal(t, r, r)
+}
@@ -1,5 +1,14 @@
 package cement
 
+// Option is the "value or nothing" counterpart of Result. Optional is its
+// implementation; Some and None construct it.
+type Option[T any] interface {
+	IsSome() bool
+	IsNone() bool
+	Unwrap() T
+	UnwrapOr(def T) T
+}
+
 type Optional[T any] struct{ t *T }
 
 func (o Optional[T]) IsNone() bool {
@@ -18,6 +27,20 @@ func (o Optional[T]) Ref() *T {
 	return o.t
 }
 
+func (o Optional[T]) Unwrap() T {
+	if o.IsNone() {
+		panic("Option is None")
+	}
+	return *o.t
+}
+
+func (o Optional[T]) UnwrapOr(def T) T {
+	if o.IsNone() {
+		return def
+	}
+	return *o.t
+}
+
 func OptionalToPtr[T any](t Optional[T]) *T {
 	if t.IsNone() {
 		return nil
@@ -53,3 +76,67 @@ func Some[T any](t T) Optional[T] {
 // func (o some[T]) Value() T {
 // 	return o.t
 // }
+
+// OkOption returns Some with the Ok value of r, or None if r is an Err.
+// The error is discarded.
+func OkOption[T any](r Result[T]) Option[T] {
+	if r.IsErr() {
+		return None[T]()
+	}
+	return Some(r.Ok())
+}
+
+// ErrOption returns Some with the error of r, or None if r is Ok.
+func ErrOption[T any](r Result[T]) Option[error] {
+	if r.IsOk() {
+		return None[error]()
+	}
+	return Some(r.Err())
+}
+
+// OptionOkOr returns Ok with the value of o, or Err(err) if o is None.
+func OptionOkOr[T any](o Option[T], err error) Result[T] {
+	if o.IsNone() {
+		return Err[T](err)
+	}
+	return Ok(o.Unwrap())
+}
+
+// Transpose maps Ok(Some(x)) to Some(Ok(x)), Ok(None) to None and Err(e)
+// to Some(Err(e)).
+func Transpose[T any](r Result[Option[T]]) Option[Result[T]] {
+	if r.IsErr() {
+		return Some(Err[T](r.Err()))
+	}
+	if r.Ok().IsNone() {
+		return None[Result[T]]()
+	}
+	return Some(Ok(r.Ok().Unwrap()))
+}
+
+// TransposeOpt is the inverse of Transpose.
+func TransposeOpt[T any](o Option[Result[T]]) Result[Option[T]] {
+	if o.IsNone() {
+		return Ok[Option[T]](None[T]())
+	}
+	r := o.Unwrap()
+	if r.IsErr() {
+		return Err[Option[T]](r.Err())
+	}
+	return Ok[Option[T]](Some(r.Ok()))
+}
+
+// CollectSome is a fallible filter-map: it keeps the Some values of the Ok
+// entries of rs in order, drops the Nones and stops at the first Err.
+func CollectSome[T any](rs []Result[Option[T]]) Result[[]T] {
+	out := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.IsErr() {
+			return Err[[]T](r.Err())
+		}
+		if o := r.Ok(); o.IsSome() {
+			out = append(out, o.Unwrap())
+		}
+	}
+	return Ok(out)
+}
@@ -1,6 +1,9 @@
 package cement
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestOptionDefault(t *testing.T) {
 	val := struct {
@@ -21,3 +24,117 @@ func TestOptionNone(t *testing.T) {
 		t.Fatal("Expected None")
 	}
 }
+
+func TestOptionSome(t *testing.T) {
+	var opt Option[int] = Some(4)
+	if !opt.IsSome() || opt.IsNone() {
+		t.Fatal("Expected Some")
+	}
+	if opt.Unwrap() != 4 {
+		t.Fatal("Expected 4")
+	}
+	if opt.UnwrapOr(5) != 4 {
+		t.Fatal("Expected 4")
+	}
+}
+
+func TestOptionUnwrapNone(t *testing.T) {
+	var opt Option[int] = None[int]()
+	if opt.UnwrapOr(5) != 5 {
+		t.Fatal("Expected 5")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected panic")
+		}
+	}()
+	opt.Unwrap()
+}
+
+func TestOkOption(t *testing.T) {
+	if OkOption(Ok(1)).Unwrap() != 1 {
+		t.Fatal("Expected Some(1)")
+	}
+	if OkOption(Err[int]("xxx")).IsSome() {
+		t.Fatal("Expected None")
+	}
+}
+
+func TestErrOption(t *testing.T) {
+	if ErrOption(Ok(1)).IsSome() {
+		t.Fatal("Expected None")
+	}
+	if ErrOption(Err[int]("xxx")).Unwrap().Error() != "xxx" {
+		t.Fatal("Expected Some(xxx)")
+	}
+}
+
+func TestOptionOkOr(t *testing.T) {
+	if OptionOkOr[int](Some(1), errors.New("missing")).Ok() != 1 {
+		t.Fatal("Expected Ok(1)")
+	}
+	if OptionOkOr(None[int](), errors.New("missing")).Err().Error() != "missing" {
+		t.Fatal("Expected Err(missing)")
+	}
+}
+
+func TestOptionRoundTripLosesError(t *testing.T) {
+	result := OptionOkOr(OkOption(Err[int]("original")), errors.New("replaced"))
+	if result.Err().Error() != "replaced" {
+		t.Fatal("Expected the original error to be lost")
+	}
+	if OptionOkOr(OkOption(Ok(7)), errors.New("replaced")).Ok() != 7 {
+		t.Fatal("Expected Ok(7)")
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	someOk := Transpose(Ok[Option[int]](Some(1)))
+	if !someOk.IsSome() || someOk.Unwrap().Ok() != 1 {
+		t.Fatal("Expected Ok(Some(1)) -> Some(Ok(1))")
+	}
+	if Transpose(Ok[Option[int]](None[int]())).IsSome() {
+		t.Fatal("Expected Ok(None) -> None")
+	}
+	sentinel := errors.New("sentinel")
+	someErr := Transpose(Err[Option[int]](sentinel))
+	if !someErr.IsSome() || someErr.Unwrap().Err() != sentinel {
+		t.Fatal("Expected Err(e) -> Some(Err(e))")
+	}
+}
+
+func TestTransposeOpt(t *testing.T) {
+	okSome := TransposeOpt[int](Some(Ok(1)))
+	if !okSome.IsOk() || okSome.Ok().Unwrap() != 1 {
+		t.Fatal("Expected Some(Ok(1)) -> Ok(Some(1))")
+	}
+	okNone := TransposeOpt(None[Result[int]]())
+	if !okNone.IsOk() || okNone.Ok().IsSome() {
+		t.Fatal("Expected None -> Ok(None)")
+	}
+	sentinel := errors.New("sentinel")
+	err := TransposeOpt[int](Some(Err[int](sentinel)))
+	if !err.IsErr() || err.Err() != sentinel {
+		t.Fatal("Expected Some(Err(e)) -> Err(e)")
+	}
+}
+
+func TestCollectSome(t *testing.T) {
+	got := CollectSome([]Result[Option[int]]{
+		Ok[Option[int]](Some(1)),
+		Ok[Option[int]](None[int]()),
+		Ok[Option[int]](Some(3)),
+	})
+	if !got.IsOk() || len(got.Ok()) != 2 || got.Ok()[0] != 1 || got.Ok()[1] != 3 {
+		t.Fatalf("Expected Ok([1 3]), got %v", got)
+	}
+	sentinel := errors.New("sentinel")
+	got = CollectSome([]Result[Option[int]]{
+		Ok[Option[int]](Some(1)),
+		Err[Option[int]](sentinel),
+		Ok[Option[int]](None[int]()),
+	})
+	if !got.IsErr() || got.Err() != sentinel {
+		t.Fatal("Expected the first Err")
+	}
+}
@@ -0,0 +1,128 @@
+package cement
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ParMap runs f over xs with at most limit concurrent calls and returns the
+// values in the order of xs. The first error cancels the context handed to
+// the remaining calls and is returned; a canceled ctx that stops xs from
+// being fully processed yields Err(ctx.Err()). limit <= 0 means one
+// goroutine per element.
+func ParMap[T, U any](ctx context.Context, limit int, xs []T, f func(context.Context, T) Result[U]) Result[[]U] {
+	if limit <= 0 || limit > len(xs) {
+		limit = len(xs)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	out := make([]U, len(xs))
+	var once sync.Once
+	var first error
+	idx := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < limit; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range idx {
+				r := f(ctx, xs[i])
+				if r.IsErr() {
+					once.Do(func() {
+						first = r.Err()
+						cancel()
+					})
+					continue
+				}
+				out[i] = r.Ok()
+			}
+		}()
+	}
+	aborted := false
+feed:
+	for i := range xs {
+		if ctx.Err() != nil {
+			aborted = true
+			break
+		}
+		select {
+		case idx <- i:
+		case <-ctx.Done():
+			aborted = true
+			break feed
+		}
+	}
+	close(idx)
+	wg.Wait()
+
+	if first != nil {
+		return Err[[]U](first)
+	}
+	if aborted {
+		return Err[[]U](ctx.Err())
+	}
+	return Ok(out)
+}
+
+// SettleError is the error ParMapSettle returns when some tasks fail. It
+// unwraps to the task errors in the order of xs, so it behaves like
+// errors.Join of them, and keeps the partial values: Values[i] holds the
+// result of xs[i], or the zero U if that task failed.
+type SettleError[U any] struct {
+	Values []U
+	Errs   []error
+}
+
+func (e *SettleError[U]) Error() string {
+	return errors.Join(e.Errs...).Error()
+}
+
+func (e *SettleError[U]) Unwrap() []error {
+	return e.Errs
+}
+
+// ParMapSettle is like ParMap but never short-circuits: every element of
+// xs is processed, even after a failure or once ctx is done (f sees ctx and
+// may bail out itself). If any task fails the Err is a *SettleError holding
+// all task errors and the partially filled values.
+func ParMapSettle[T, U any](ctx context.Context, limit int, xs []T, f func(context.Context, T) Result[U]) Result[[]U] {
+	if limit <= 0 || limit > len(xs) {
+		limit = len(xs)
+	}
+	out := make([]U, len(xs))
+	errs := make([]error, len(xs))
+	idx := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < limit; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range idx {
+				r := f(ctx, xs[i])
+				if r.IsErr() {
+					errs[i] = r.Err()
+					continue
+				}
+				out[i] = r.Ok()
+			}
+		}()
+	}
+	for i := range xs {
+		idx <- i
+	}
+	close(idx)
+	wg.Wait()
+
+	var failed []error
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+	if len(failed) > 0 {
+		return Err[[]U](&SettleError[U]{Values: out, Errs: failed})
+	}
+	return Ok(out)
+}
@@ -0,0 +1,116 @@
+package cement
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParMapPreservesOrder(t *testing.T) {
+	xs := []int{5, 4, 3, 2, 1, 0}
+	result := ParMap(context.Background(), 3, xs, func(_ context.Context, x int) Result[int] {
+		time.Sleep(time.Duration(x) * time.Millisecond)
+		return Ok(x * 10)
+	})
+	assert.DeepEqual(t, result.Ok(), []int{50, 40, 30, 20, 10, 0})
+}
+
+func TestParMapBoundsConcurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	xs := make([]int, 20)
+	result := ParMap(context.Background(), 4, xs, func(_ context.Context, x int) Result[int] {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		return Ok(x)
+	})
+	assert.Equal(t, result.IsOk(), true)
+	assert.Assert(t, peak.Load() <= 4)
+}
+
+func TestParMapUnlimited(t *testing.T) {
+	result := ParMap(context.Background(), 0, []int{1, 2, 3}, func(_ context.Context, x int) Result[int] {
+		return Ok(x + 1)
+	})
+	assert.DeepEqual(t, result.Ok(), []int{2, 3, 4})
+	assert.Equal(t, len(ParMap(context.Background(), 0, []int{}, func(_ context.Context, x int) Result[int] {
+		return Ok(x)
+	}).Ok()), 0)
+}
+
+func TestParMapFirstErrorCancels(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	var calls atomic.Int32
+	xs := make([]int, 10)
+	xs[0] = 1
+	start := time.Now()
+	result := ParMap(context.Background(), 2, xs, func(ctx context.Context, x int) Result[int] {
+		calls.Add(1)
+		if x == 1 {
+			return Err[int](sentinel)
+		}
+		select {
+		case <-ctx.Done():
+			return Err[int](ctx.Err())
+		case <-time.After(time.Second):
+			return Ok(x)
+		}
+	})
+	assert.Equal(t, result.Err(), sentinel)
+	assert.Assert(t, calls.Load() < 10)
+	assert.Assert(t, time.Since(start) < 500*time.Millisecond)
+}
+
+func TestParMapParentCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := ParMap(ctx, 1, []int{1, 2, 3}, func(_ context.Context, x int) Result[int] {
+		return Ok(x)
+	})
+	assert.Equal(t, result.IsErr(), true)
+	assert.Equal(t, result.Err(), context.Canceled)
+}
+
+func TestParMapSettleAllOk(t *testing.T) {
+	xs := []int{5, 4, 3, 2, 1, 0}
+	result := ParMapSettle(context.Background(), 3, xs, func(_ context.Context, x int) Result[int] {
+		time.Sleep(time.Duration(x) * time.Millisecond)
+		return Ok(x * 10)
+	})
+	assert.DeepEqual(t, result.Ok(), []int{50, 40, 30, 20, 10, 0})
+}
+
+func TestParMapSettleRunsEverything(t *testing.T) {
+	e1, e3 := errors.New("e1"), errors.New("e3")
+	var calls atomic.Int32
+	xs := []int{0, 1, 2, 3, 4}
+	result := ParMapSettle(context.Background(), 2, xs, func(_ context.Context, x int) Result[int] {
+		calls.Add(1)
+		switch x {
+		case 1:
+			return Err[int](e1)
+		case 3:
+			return Err[int](e3)
+		}
+		return Ok(x * 10)
+	})
+	assert.Equal(t, calls.Load(), int32(5))
+	assert.Assert(t, result.IsErr())
+	assert.Assert(t, errors.Is(result.Err(), e1))
+	assert.Assert(t, errors.Is(result.Err(), e3))
+	assert.Error(t, result.Err(), "e1\ne3")
+
+	settle, ok := CatchAs[[]int, *SettleError[int]](result)
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, settle.Values, []int{0, 0, 20, 0, 40})
+}
@@ -0,0 +1,45 @@
+package cement
+
+import "context"
+
+// Pipeline threads a value through a fixed list of fallible steps.
+type Pipeline[T any] struct {
+	steps []func(T) Result[T]
+}
+
+func NewPipeline[T any]() *Pipeline[T] {
+	return &Pipeline[T]{}
+}
+
+// Then appends step and returns p for chaining.
+func (p *Pipeline[T]) Then(step func(T) Result[T]) *Pipeline[T] {
+	p.steps = append(p.steps, step)
+	return p
+}
+
+// Run feeds initial through the steps in order. The first Err stops the
+// pipeline and is returned; later steps are not run.
+func (p *Pipeline[T]) Run(initial T) Result[T] {
+	r := Ok(initial)
+	for _, step := range p.steps {
+		r = AndThen(r, step)
+	}
+	return r
+}
+
+// RunPipelineCtx is Run for context-aware steps given inline. ctx is
+// checked before every step: once it is done the pipeline stops with
+// Err(ctx.Err()) and no further step runs.
+func RunPipelineCtx[T any](ctx context.Context, initial T, steps ...func(context.Context, T) Result[T]) Result[T] {
+	r := Ok(initial)
+	for _, step := range steps {
+		if err := ctx.Err(); err != nil {
+			return Err[T](err)
+		}
+		r = step(ctx, r.Ok())
+		if r.IsErr() {
+			return r
+		}
+	}
+	return r
+}
@@ -0,0 +1,73 @@
+package cement
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestPipelineRun(t *testing.T) {
+	p := NewPipeline[string]().
+		Then(func(s string) Result[string] { return Ok(strings.TrimSpace(s)) }).
+		Then(func(s string) Result[string] { return Ok(strings.ToUpper(s)) })
+	assert.Equal(t, p.Run("  hello ").Ok(), "HELLO")
+	assert.Equal(t, p.Run("x").Ok(), "X")
+	assert.Equal(t, NewPipeline[int]().Run(1).Ok(), 1)
+}
+
+func TestPipelineStopsAtErr(t *testing.T) {
+	sentinel := errors.New("empty")
+	ran := []string{}
+	result := NewPipeline[string]().
+		Then(func(s string) Result[string] {
+			ran = append(ran, "trim")
+			return Ok(strings.TrimSpace(s))
+		}).
+		Then(func(s string) Result[string] {
+			ran = append(ran, "check")
+			if s == "" {
+				return Err[string](sentinel)
+			}
+			return Ok(s)
+		}).
+		Then(func(s string) Result[string] {
+			ran = append(ran, "upper")
+			return Ok(strings.ToUpper(s))
+		}).
+		Run("   ")
+	assert.Equal(t, result.Err(), sentinel)
+	assert.DeepEqual(t, ran, []string{"trim", "check"})
+}
+
+func TestRunPipelineCtx(t *testing.T) {
+	inc := func(_ context.Context, v int) Result[int] { return Ok(v + 1) }
+	assert.Equal(t, RunPipelineCtx(context.Background(), 1, inc, inc, inc).Ok(), 4)
+	assert.Equal(t, RunPipelineCtx(context.Background(), 1).Ok(), 1)
+}
+
+func TestRunPipelineCtxCanceledBetweenSteps(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	third := false
+	r := RunPipelineCtx(ctx, 1,
+		func(_ context.Context, v int) Result[int] { return Ok(v + 1) },
+		func(_ context.Context, v int) Result[int] { cancel(); return Ok(v + 1) },
+		func(_ context.Context, v int) Result[int] { third = true; return Err[int]("step error") },
+	)
+	assert.Equal(t, r.Err(), context.Canceled)
+	assert.Equal(t, third, false)
+}
+
+func TestRunPipelineCtxStepError(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	r := RunPipelineCtx(context.Background(), 1,
+		func(_ context.Context, v int) Result[int] { calls++; return Err[int](boom) },
+		func(_ context.Context, v int) Result[int] { calls++; return Ok(v) },
+	)
+	assert.Equal(t, r.Err(), boom)
+	assert.Equal(t, calls, 1)
+}
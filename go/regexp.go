@@ -0,0 +1,30 @@
+package cement
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// ErrNoMatch is wrapped by the Err of MatchRegexp and MatchRe when the
+// pattern does not match, to tell it apart from a bad pattern.
+var ErrNoMatch = errors.New("no match")
+
+// MatchRegexp compiles pattern and returns the submatches of its leftmost
+// match in s as FindStringSubmatch does. A bad pattern is an Err with the
+// *syntax.Error from regexp.Compile.
+func MatchRegexp(pattern, s string) Result[[]string] {
+	return AndThen(From(regexp.Compile(pattern)), func(re *regexp.Regexp) Result[[]string] {
+		return MatchRe(re, s)
+	})
+}
+
+// MatchRe is MatchRegexp for an already compiled re, for use in loops. It
+// is not named Match, which already folds a Result.
+func MatchRe(re *regexp.Regexp, s string) Result[[]string] {
+	m := re.FindStringSubmatch(s)
+	if m == nil {
+		return Err[[]string](fmt.Errorf("%w: %s in %q", ErrNoMatch, re, s))
+	}
+	return Ok(m)
+}
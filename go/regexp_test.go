@@ -0,0 +1,34 @@
+package cement
+
+import (
+	"errors"
+	"regexp"
+	"regexp/syntax"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMatchRegexp(t *testing.T) {
+	r := MatchRegexp(`(\w+)=(\d+)`, "x a=1 b=2")
+	assert.DeepEqual(t, r.Ok(), []string{"a=1", "a", "1"})
+}
+
+func TestMatchRegexpBadPattern(t *testing.T) {
+	r := MatchRegexp(`(`, "x")
+	var synErr *syntax.Error
+	assert.Assert(t, errors.As(r.Err(), &synErr))
+	assert.Assert(t, !errors.Is(r.Err(), ErrNoMatch))
+}
+
+func TestMatchRegexpNoMatch(t *testing.T) {
+	r := MatchRegexp(`\d+`, "abc")
+	assert.Assert(t, errors.Is(r.Err(), ErrNoMatch))
+	assert.Error(t, r.Err(), `no match: \d+ in "abc"`)
+}
+
+func TestMatchRe(t *testing.T) {
+	re := regexp.MustCompile(`^v(\d+)$`)
+	assert.DeepEqual(t, MatchRe(re, "v12").Ok(), []string{"v12", "12"})
+	assert.Assert(t, errors.Is(MatchRe(re, "x12").Err(), ErrNoMatch))
+}
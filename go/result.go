@@ -1,7 +1,27 @@
 package cement
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"reflect"
+	"strings"
+)
 
+// Result holds either an Ok value of type T or an error.
+//
+// Unwrap returns the Ok value, so a Result can not take part in the
+// errors.Unwrap chain, which expects Unwrap() error. Is and As fill that
+// gap: they follow the errors.Is/errors.As contract and walk the chain of
+// the contained error.
+//
+// WithMeta attaches key/value metadata that rides along without changing
+// T. Results derived from r by its methods, Map, AndThen, Join and Bimap
+// keep r's metadata; a Result returned by a callback, as in AndThen or
+// OrElse, keeps its own entries in front. Results built by Ok and Err
+// carry none and Meta reports false for every key.
 type Result[T any] interface {
 	IsOk() bool
 	IsErr() bool
@@ -9,10 +29,61 @@ type Result[T any] interface {
 	Ok() T
 	Unwrap() T
 	UnwrapErr() error
+	Map(f func(T) T) Result[T]
+	UnwrapOr(def T) T
+	UnwrapOrElse(f func(error) T) T
+	Split() (T, error)
+	Fold(onOk func(T) T, onErr func(error) T) T
+	Is(target error) bool
+	As(target any) bool
+	MapErr(f func(error) error) Result[T]
+	OrElse(f func(error) Result[T]) Result[T]
+	Inspect(f func(T)) Result[T]
+	InspectErr(f func(error)) Result[T]
+	IsOkAnd(pred func(T) bool) bool
+	IsErrAnd(pred func(error) bool) bool
+	String() string
+	GoString() string
+	LogValue() slog.Value
+	Filter(pred func(T) bool, err error) Result[T]
+	Ensure(check func(T) error) Result[T]
+	Seq() iter.Seq[T]
+	Seq2() iter.Seq2[T, error]
+	Swap() Result[error]
+	Expect(msg string) T
+	UnwrapOrZero() T
+	Tap(f func(Result[T])) Result[T]
+	WrapErr(format string, args ...any) Result[T]
+	OkPtr() (*T, error)
+	Recover(f func(error) Result[T]) Result[T]
+	RecoverIf(match func(error) bool, value T) Result[T]
+	AsError() error
+	WithMeta(key string, value any) Result[T]
+	Meta(key string) (any, bool)
+	IsErrTimeout() bool
+	IsErrCanceled() bool
+	Value() (T, bool)
+	WithDefault(def T) Result[T]
+	SetErr(err error) Result[T]
+	Replace(v T) Result[T]
+	StackTrace() []uintptr
+	TeeTo(ch chan<- Result[T]) Result[T]
+	TeeToBlocking(ch chan<- Result[T]) Result[T]
+	Chain(fs ...func(T) Result[T]) Result[T]
+	EnsureCtx(ctx context.Context) Result[T]
+	Explain() string
+	Or(other Result[T]) Result[T]
+	WithFields(fields map[string]any) Result[T]
+	UnwrapBoth() (T, error, bool)
+	Finally(f func()) Result[T]
+	ToChans() (<-chan T, <-chan error)
+	Normalize() Result[T]
+	Debug(label string) Result[T]
 }
 
 type ResultOK[T any] struct {
-	t T
+	t    T
+	meta *metaEntry
 }
 
 func (r ResultOK[T]) Unwrap() T {
@@ -37,8 +108,261 @@ func (r ResultOK[T]) Ok() T {
 	return r.t
 }
 
+func (r ResultOK[T]) Map(f func(T) T) Result[T] {
+	return okMeta(f(r.t), r.meta)
+}
+
+func (r ResultOK[T]) UnwrapOr(def T) T {
+	return r.t
+}
+
+func (r ResultOK[T]) UnwrapOrElse(f func(error) T) T {
+	return r.t
+}
+
+func (r ResultOK[T]) Split() (T, error) {
+	return r.t, nil
+}
+
+func (r ResultOK[T]) Fold(onOk func(T) T, onErr func(error) T) T {
+	return onOk(r.t)
+}
+
+func (r ResultOK[T]) Is(target error) bool {
+	return false
+}
+
+func (r ResultOK[T]) As(target any) bool {
+	return false
+}
+
+func (r ResultOK[T]) MapErr(f func(error) error) Result[T] {
+	return r
+}
+
+func (r ResultOK[T]) OrElse(f func(error) Result[T]) Result[T] {
+	return r
+}
+
+func (r ResultOK[T]) Inspect(f func(T)) Result[T] {
+	f(r.t)
+	return r
+}
+
+func (r ResultOK[T]) InspectErr(f func(error)) Result[T] {
+	return r
+}
+
+func (r ResultOK[T]) IsOkAnd(pred func(T) bool) bool {
+	return pred(r.t)
+}
+
+func (r ResultOK[T]) IsErrAnd(pred func(error) bool) bool {
+	return false
+}
+
+func (r ResultOK[T]) String() string {
+	return fmt.Sprintf("Ok(%v)", r.t)
+}
+
+func (r ResultOK[T]) GoString() string {
+	return fmt.Sprintf("cement.Ok[%s](%#v)", reflect.TypeFor[T](), r.t)
+}
+
+func (r ResultOK[T]) LogValue() slog.Value {
+	if lv, ok := any(r.t).(slog.LogValuer); ok {
+		return slog.GroupValue(slog.Attr{Key: "ok", Value: lv.LogValue()})
+	}
+	return slog.GroupValue(slog.Any("ok", r.t))
+}
+
+func (r ResultOK[T]) Filter(pred func(T) bool, err error) Result[T] {
+	if !pred(r.t) {
+		return errMeta[T](err, r.meta)
+	}
+	return r
+}
+
+func (r ResultOK[T]) Ensure(check func(T) error) Result[T] {
+	if err := check(r.t); err != nil {
+		return errMeta[T](err, r.meta)
+	}
+	return r
+}
+
+func (r ResultOK[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		yield(r.t)
+	}
+}
+
+func (r ResultOK[T]) Seq2() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		yield(r.t, nil)
+	}
+}
+
+// Swap turns the Ok into an Err reading "expected error but got value: <v>".
+func (r ResultOK[T]) Swap() Result[error] {
+	return errMeta[error](fmt.Errorf("expected error but got value: %v", r.t), r.meta)
+}
+
+func (r ResultOK[T]) Expect(msg string) T {
+	return r.t
+}
+
+func (r ResultOK[T]) UnwrapOrZero() T {
+	return r.t
+}
+
+func (r ResultOK[T]) Tap(f func(Result[T])) Result[T] {
+	f(r)
+	return r
+}
+
+func (r ResultOK[T]) WrapErr(format string, args ...any) Result[T] {
+	return r
+}
+
+// OkPtr returns a pointer to a copy of the value, so writes through it do
+// not reach the Result.
+func (r ResultOK[T]) OkPtr() (*T, error) {
+	t := r.t
+	return &t, nil
+}
+
+func (r ResultOK[T]) Recover(f func(error) Result[T]) Result[T] {
+	return r
+}
+
+func (r ResultOK[T]) RecoverIf(match func(error) bool, value T) Result[T] {
+	return r
+}
+
+func (r ResultOK[T]) AsError() error {
+	return nil
+}
+
+func (r ResultOK[T]) WithMeta(key string, value any) Result[T] {
+	r.meta = &metaEntry{key: key, value: value, next: r.meta}
+	return r
+}
+
+func (r ResultOK[T]) Meta(key string) (any, bool) {
+	return r.meta.lookup(key)
+}
+
+func (r ResultOK[T]) IsErrTimeout() bool {
+	return false
+}
+
+func (r ResultOK[T]) IsErrCanceled() bool {
+	return false
+}
+
+func (r ResultOK[T]) Value() (T, bool) {
+	return r.t, true
+}
+
+func (r ResultOK[T]) WithDefault(def T) Result[T] {
+	return r
+}
+
+// SetErr turns r into an Err with err, keeping T and the metadata, so
+// generic code can write r.SetErr(err) instead of spelling Err[T](err).
+func (r ResultOK[T]) SetErr(err error) Result[T] {
+	return errMeta[T](err, r.meta)
+}
+
+func (r ResultOK[T]) Replace(v T) Result[T] {
+	return okMeta(v, r.meta)
+}
+
+func (r ResultOK[T]) StackTrace() []uintptr {
+	return nil
+}
+
+// TeeTo forks r onto ch for an observer and returns r unchanged. The send
+// never blocks: if ch is not ready r is dropped, so a slow collector
+// cannot stall the pipeline. TeeToBlocking waits instead.
+func (r ResultOK[T]) TeeTo(ch chan<- Result[T]) Result[T] {
+	return teeTo[T](r, ch, false)
+}
+
+func (r ResultOK[T]) TeeToBlocking(ch chan<- Result[T]) Result[T] {
+	return teeTo[T](r, ch, true)
+}
+
+// Chain applies fs in order, each to the Ok value of the previous step,
+// and stops at the first Err.
+func (r ResultOK[T]) Chain(fs ...func(T) Result[T]) Result[T] {
+	var cur Result[T] = r
+	for _, f := range fs {
+		if cur.IsErr() {
+			break
+		}
+		cur = withMeta(f(cur.Ok()), metaOf(cur))
+	}
+	return cur
+}
+
+// EnsureCtx is a cancellation checkpoint between pipeline stages: an Ok
+// becomes Err(ctx.Err()) once ctx is done. An Err keeps its own error.
+func (r ResultOK[T]) EnsureCtx(ctx context.Context) Result[T] {
+	if err := ctx.Err(); err != nil {
+		return errMeta[T](err, r.meta)
+	}
+	return r
+}
+
+// Explain is a verbose, multi-line rendering for test failures: the
+// variant with its type, then the value formatted with %+v.
+func (r ResultOK[T]) Explain() string {
+	return fmt.Sprintf("Ok[%s]\n  %s", reflect.TypeFor[T](), indentLines(fmt.Sprintf("%+v", r.t), "  "))
+}
+
+// Or returns r if it is Ok and the already computed other otherwise; use
+// OrElse when the fallback should only be computed on demand.
+func (r ResultOK[T]) Or(other Result[T]) Result[T] {
+	return r
+}
+
+func (r ResultOK[T]) WithFields(fields map[string]any) Result[T] {
+	return r
+}
+
+// UnwrapBoth destructures r in one call without panicking.
+func (r ResultOK[T]) UnwrapBoth() (T, error, bool) {
+	return r.t, nil, true
+}
+
+// Finally runs f once on either branch and returns r unchanged, like a
+// defer placed inline in a chain.
+func (r ResultOK[T]) Finally(f func()) Result[T] {
+	f()
+	return r
+}
+
+// ToChans hands r to select-based code: the value channel delivers the Ok
+// value, the error channel is closed empty, and both are closed once
+// drained. For an Err it is the other way round.
+func (r ResultOK[T]) ToChans() (<-chan T, <-chan error) {
+	return toChans(r.t, nil)
+}
+
+func (r ResultOK[T]) Normalize() Result[T] {
+	return r
+}
+
+// Debug prints label and r to stderr when DebugEnabled is set and returns
+// r unchanged; otherwise it only costs the check of the flag.
+func (r ResultOK[T]) Debug(label string) Result[T] {
+	return debug[T](r, label)
+}
+
 type ResultError[T any] struct {
-	t error
+	t    error
+	meta *metaEntry
 }
 
 func (r ResultError[T]) IsOk() bool {
@@ -64,29 +388,366 @@ func (r ResultError[T]) Err() error {
 	return r.t
 }
 
+func (r ResultError[T]) Map(f func(T) T) Result[T] {
+	return r
+}
+
+func (r ResultError[T]) UnwrapOr(def T) T {
+	return def
+}
+
+func (r ResultError[T]) UnwrapOrElse(f func(error) T) T {
+	return f(r.t)
+}
+
+func (r ResultError[T]) Split() (T, error) {
+	var zero T
+	return zero, r.t
+}
+
+func (r ResultError[T]) Fold(onOk func(T) T, onErr func(error) T) T {
+	return onErr(r.t)
+}
+
+func (r ResultError[T]) Is(target error) bool {
+	return errors.Is(r.t, target)
+}
+
+func (r ResultError[T]) As(target any) bool {
+	return errors.As(r.t, target)
+}
+
+func (r ResultError[T]) MapErr(f func(error) error) Result[T] {
+	return errMeta[T](f(r.t), r.meta)
+}
+
+func (r ResultError[T]) OrElse(f func(error) Result[T]) Result[T] {
+	return withMeta(f(r.t), r.meta)
+}
+
+func (r ResultError[T]) Inspect(f func(T)) Result[T] {
+	return r
+}
+
+func (r ResultError[T]) InspectErr(f func(error)) Result[T] {
+	f(r.t)
+	return r
+}
+
+func (r ResultError[T]) IsOkAnd(pred func(T) bool) bool {
+	return false
+}
+
+func (r ResultError[T]) IsErrAnd(pred func(error) bool) bool {
+	return pred(r.t)
+}
+
+func (r ResultError[T]) String() string {
+	return fmt.Sprintf("Err(%s)", r.t.Error())
+}
+
+func (r ResultError[T]) GoString() string {
+	return fmt.Sprintf("cement.Err[%s](%q)", reflect.TypeFor[T](), r.t.Error())
+}
+
+func (r ResultError[T]) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("error", r.t.Error()))
+}
+
+func (r ResultError[T]) Filter(pred func(T) bool, err error) Result[T] {
+	return r
+}
+
+func (r ResultError[T]) Ensure(check func(T) error) Result[T] {
+	return r
+}
+
+func (r ResultError[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {}
+}
+
+func (r ResultError[T]) Seq2() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, r.t)
+	}
+}
+
+// Swap turns the Err into Ok holding the error.
+func (r ResultError[T]) Swap() Result[error] {
+	return okMeta(r.t, r.meta)
+}
+
+// Expect panics with an error reading msg + ": " + the contained error,
+// which stays reachable through errors.Unwrap.
+func (r ResultError[T]) Expect(msg string) T {
+	panic(fmt.Errorf("%s: %w", msg, r.t))
+}
+
+func (r ResultError[T]) UnwrapOrZero() T {
+	var zero T
+	return zero
+}
+
+func (r ResultError[T]) Tap(f func(Result[T])) Result[T] {
+	f(r)
+	return r
+}
+
+// WrapErr annotates the error as fmt.Errorf(format+": %w", args..., err).
+func (r ResultError[T]) WrapErr(format string, args ...any) Result[T] {
+	return errMeta[T](fmt.Errorf(format+": %w", append(args, r.t)...), r.meta)
+}
+
+func (r ResultError[T]) OkPtr() (*T, error) {
+	return nil, r.t
+}
+
+func (r ResultError[T]) Recover(f func(error) Result[T]) Result[T] {
+	return withMeta(f(r.t), r.meta)
+}
+
+func (r ResultError[T]) RecoverIf(match func(error) bool, value T) Result[T] {
+	if match(r.t) {
+		return okMeta(value, r.meta)
+	}
+	return r
+}
+
+// AsError is the way to hand a Result to code expecting an error.
+// ResultError deliberately does not implement error itself: its Unwrap
+// returns T, which would break the errors.Unwrap contract for an error
+// type (go vet rejects it), so do not add an Error method.
+// TestResultErrorIsNotAnError guards this.
+func (r ResultError[T]) AsError() error {
+	return r.t
+}
+
+func (r ResultError[T]) WithMeta(key string, value any) Result[T] {
+	r.meta = &metaEntry{key: key, value: value, next: r.meta}
+	return r
+}
+
+func (r ResultError[T]) Meta(key string) (any, bool) {
+	return r.meta.lookup(key)
+}
+
+func (r ResultError[T]) IsErrTimeout() bool {
+	return isTimeout(r.t)
+}
+
+func (r ResultError[T]) IsErrCanceled() bool {
+	return isCanceled(r.t)
+}
+
+func (r ResultError[T]) Value() (T, bool) {
+	var t T
+	return t, false
+}
+
+// WithDefault recovers to Ok(def) while staying a Result, unlike UnwrapOr
+// which extracts the value.
+func (r ResultError[T]) WithDefault(def T) Result[T] {
+	return okMeta(def, r.meta)
+}
+
+func (r ResultError[T]) SetErr(err error) Result[T] {
+	return errMeta[T](err, r.meta)
+}
+
+func (r ResultError[T]) Replace(v T) Result[T] {
+	return r
+}
+
+// StackTrace returns the call stack recorded by ErrTrace, or nil for an
+// Err built without one.
+func (r ResultError[T]) StackTrace() []uintptr {
+	return stackTrace(r.t)
+}
+
+func (r ResultError[T]) TeeTo(ch chan<- Result[T]) Result[T] {
+	return teeTo[T](r, ch, false)
+}
+
+func (r ResultError[T]) TeeToBlocking(ch chan<- Result[T]) Result[T] {
+	return teeTo[T](r, ch, true)
+}
+
+func (r ResultError[T]) Chain(fs ...func(T) Result[T]) Result[T] {
+	return r
+}
+
+func (r ResultError[T]) EnsureCtx(ctx context.Context) Result[T] {
+	return r
+}
+
+// Explain renders the variant with its type, then every link of the error
+// chain with its dynamic type and %+v, nested one level per wrap.
+func (r ResultError[T]) Explain() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Err[%s]", reflect.TypeFor[T]())
+	explainChain(&sb, r.t, 1)
+	return sb.String()
+}
+
+func (r ResultError[T]) Or(other Result[T]) Result[T] {
+	return other
+}
+
+// WithFields wraps the error in a *FieldError carrying fields, merged with
+// the fields of an earlier WithFields. errors.Is and errors.As still see
+// the original error.
+func (r ResultError[T]) WithFields(fields map[string]any) Result[T] {
+	return errMeta[T](withFields(r.t, fields), r.meta)
+}
+
+func (r ResultError[T]) UnwrapBoth() (T, error, bool) {
+	var t T
+	return t, r.t, false
+}
+
+func (r ResultError[T]) Finally(f func()) Result[T] {
+	f()
+	return r
+}
+
+func (r ResultError[T]) ToChans() (<-chan T, <-chan error) {
+	var t T
+	return toChans(t, r.t)
+}
+
+// Normalize turns an Err holding a nil error, which only a bare
+// ResultError[T]{} literal can produce since Err rejects nil, into Ok of
+// the zero T. Any other Result is returned unchanged.
+func (r ResultError[T]) Normalize() Result[T] {
+	if r.t == nil {
+		var t T
+		return okMeta(t, r.meta)
+	}
+	return r
+}
+
+func (r ResultError[T]) Debug(label string) Result[T] {
+	return debug[T](r, label)
+}
+
 func Ok[T any](t T) Result[T] {
 	return ResultOK[T]{
 		t: t,
 	}
 }
 
+// Err builds an error Result from an error, a string, a fmt.Stringer or a
+// []byte. A nil value or any other type panics.
 func Err[T any](t any) Result[T] {
-	e, ok := t.(error)
-	if ok {
-		return ResultError[T]{t: e}
+	switch v := t.(type) {
+	case nil:
+		panic("Err must not be nil")
+	case error:
+		return ResultError[T]{t: v}
+	case string:
+		return ResultError[T]{t: errors.New(v)}
+	case fmt.Stringer:
+		return ResultError[T]{t: errors.New(v.String())}
+	case []byte:
+		return ResultError[T]{t: errors.New(string(v))}
+	default:
+		panic(fmt.Sprintf("Err must be error, string, fmt.Stringer or []byte, got %T", t))
+	}
+}
+
+// From lifts Go's (T, error) return convention into a Result:
+// cement.From(os.Open(path)).
+func From[T any](t T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(t)
+}
+
+// OkOr lifts the comma-ok idiom into a Result: Ok(t) if ok, otherwise
+// Err(err) with t ignored. cement.OkOr(m[k], exists, ErrMissing)
+func OkOr[T any](t T, ok bool, err error) Result[T] {
+	if !ok {
+		return Err[T](err)
+	}
+	return Ok(t)
+}
+
+// OkOrElse is like OkOr but only builds the error when ok is false.
+func OkOrElse[T any](t T, ok bool, err func() error) Result[T] {
+	if !ok {
+		return Err[T](err())
 	}
-	s, ok := t.(string)
-	if ok {
-		return ResultError[T]{t: fmt.Errorf(s)}
+	return Ok(t)
+}
+
+// FromPtr lifts a nullable pointer into a Result: Ok(*p), or Err(errIfNil)
+// when p is nil.
+func FromPtr[T any](p *T, errIfNil error) Result[T] {
+	if p == nil {
+		return Err[T](errIfNil)
 	}
-	panic("Err must be error or string")
+	return Ok(*p)
+}
+
+// ToPtr is the inverse of FromPtr: a pointer to a copy of the Ok value, or
+// nil for an Err, whose error is dropped.
+func ToPtr[T any](r Result[T]) *T {
+	p, _ := r.OkPtr()
+	return p
+}
+
+// ErrFrom builds an Err with err, taking T from t, which is otherwise
+// ignored. It pairs with SetErr for generic code: Go cannot infer a type
+// parameter from the expected result type, so without a value or Result of
+// T at hand Err[T] still needs the explicit parameter. Like Err, a nil err
+// panics.
+func ErrFrom[T any](t T, err error) Result[T] {
+	return Err[T](err)
 }
 
 func Is[T any](t any) bool {
 	switch t.(type) {
-	case ResultOK[T], ResultError[T]:
+	case ResultOK[T], ResultError[T], ResultValue[T]:
 		return true
 	default:
 		return false
 	}
 }
+
+// IsOkType reports whether t is an Ok Result[T].
+func IsOkType[T any](t any) bool {
+	switch v := t.(type) {
+	case ResultOK[T]:
+		return true
+	case ResultValue[T]:
+		return v.IsOk()
+	default:
+		return false
+	}
+}
+
+// IsErrType reports whether t is an Err Result[T].
+func IsErrType[T any](t any) bool {
+	switch v := t.(type) {
+	case ResultError[T]:
+		return true
+	case ResultValue[T]:
+		return v.IsErr()
+	default:
+		return false
+	}
+}
+
+// CatchAs extracts a typed error E from the chain of an Err with errors.As.
+// It returns the zero E and false for an Ok or when nothing in the chain
+// matches.
+func CatchAs[T any, E error](r Result[T]) (E, bool) {
+	var e E
+	if r.IsOk() {
+		return e, false
+	}
+	ok := errors.As(r.Err(), &e)
+	return e, ok
+}
@@ -1,10 +1,14 @@
 package cement
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"testing"
 
 	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
 )
 
 func TestResultOK(t *testing.T) {
@@ -31,3 +35,551 @@ func TestIsResult(t *testing.T) {
 	assert.Equal(t, Is[int](Err[int]("xxx")), true)
 	assert.Equal(t, Is[int](44), false)
 }
+
+func TestUnwrapOr(t *testing.T) {
+	assert.Equal(t, Ok(1).UnwrapOr(2), 1)
+	assert.Equal(t, Err[int]("xxx").UnwrapOr(2), 2)
+}
+
+func TestUnwrapOrElse(t *testing.T) {
+	called := false
+	assert.Equal(t, Ok(1).UnwrapOrElse(func(error) int {
+		called = true
+		return 2
+	}), 1)
+	assert.Equal(t, called, false)
+
+	var got error
+	assert.Equal(t, Err[int]("xxx").UnwrapOrElse(func(err error) int {
+		got = err
+		return 2
+	}), 2)
+	assert.Equal(t, got.Error(), "xxx")
+}
+
+func TestFrom(t *testing.T) {
+	result := From(strconv.Atoi("42"))
+	assert.Equal(t, result.Ok(), 42)
+
+	result = From(strconv.Atoi("x"))
+	assert.Equal(t, result.IsErr(), true)
+	var numErr *strconv.NumError
+	assert.Assert(t, errors.As(result.Err(), &numErr))
+}
+
+func TestSplit(t *testing.T) {
+	v, err := Ok(1).Split()
+	assert.NilError(t, err)
+	assert.Equal(t, v, 1)
+
+	s, err := Err[string]("xxx").Split()
+	assert.Error(t, err, "xxx")
+	assert.Equal(t, s, "")
+}
+
+type testError struct{ code int }
+
+func (e *testError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+func TestResultIsAs(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	result := Err[int](fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", sentinel)))
+	assert.Equal(t, result.Is(sentinel), true)
+	assert.Equal(t, result.Is(errors.New("sentinel")), false)
+	assert.Equal(t, errors.Is(result.Err(), sentinel), true)
+
+	result = Err[int](fmt.Errorf("wrapped: %w", &testError{code: 7}))
+	var target *testError
+	assert.Equal(t, result.As(&target), true)
+	assert.Equal(t, target.code, 7)
+
+	assert.Equal(t, Ok(1).Is(sentinel), false)
+	assert.Equal(t, Ok(1).As(&target), false)
+}
+
+func TestMapErrMethod(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	result := Err[int](sentinel).MapErr(func(e error) error {
+		return fmt.Errorf("loading config: %w", e)
+	})
+	assert.Equal(t, result.Err().Error(), "loading config: sentinel")
+	assert.Assert(t, errors.Is(result.Err(), sentinel))
+
+	called := false
+	result = Ok(1).MapErr(func(e error) error {
+		called = true
+		return e
+	})
+	assert.Equal(t, called, false)
+	assert.Equal(t, result.Ok(), 1)
+}
+
+func TestOrElse(t *testing.T) {
+	called := false
+	result := Ok(1).OrElse(func(error) Result[int] {
+		called = true
+		return Ok(2)
+	})
+	assert.Equal(t, called, false)
+	assert.Equal(t, result.Ok(), 1)
+
+	primary := errors.New("primary")
+	var seen error
+	result = Err[int](primary).OrElse(func(err error) Result[int] {
+		seen = err
+		return Ok(2)
+	})
+	assert.Equal(t, seen, primary)
+	assert.Equal(t, result.Ok(), 2)
+
+	result = Err[int](primary).OrElse(func(error) Result[int] {
+		return Err[int]("secondary")
+	})
+	assert.Equal(t, result.Err().Error(), "secondary")
+}
+
+func TestInspect(t *testing.T) {
+	seen := []string{}
+	result := Ok(1).
+		Inspect(func(i int) { seen = append(seen, "ok:"+strconv.Itoa(i)) }).
+		InspectErr(func(err error) { seen = append(seen, "err:"+err.Error()) })
+	assert.Equal(t, result.Ok(), 1)
+	assert.DeepEqual(t, seen, []string{"ok:1"})
+
+	seen = []string{}
+	result = Err[int]("xxx").
+		Inspect(func(i int) { seen = append(seen, "ok:"+strconv.Itoa(i)) }).
+		InspectErr(func(err error) { seen = append(seen, "err:"+err.Error()) })
+	assert.Equal(t, result.Err().Error(), "xxx")
+	assert.DeepEqual(t, seen, []string{"err:xxx"})
+}
+
+type testStringer struct{}
+
+func (testStringer) String() string {
+	return "stringer"
+}
+
+func TestErrAccepts(t *testing.T) {
+	assert.Equal(t, Err[int]("string").Err().Error(), "string")
+	assert.Equal(t, Err[int]("100%s").Err().Error(), "100%s")
+	assert.Equal(t, Err[int](testStringer{}).Err().Error(), "stringer")
+	assert.Equal(t, Err[int]([]byte("bytes")).Err().Error(), "bytes")
+	err := &testError{code: 1}
+	assert.Equal(t, Err[int](err).Err(), error(err))
+}
+
+func TestErrRejects(t *testing.T) {
+	assert.Assert(t, cmp.Panics(func() { Err[int](nil) }))
+	defer func() {
+		assert.Equal(t, recover(), "Err must be error, string, fmt.Stringer or []byte, got int")
+	}()
+	Err[int](42)
+}
+
+func TestIsOkAnd(t *testing.T) {
+	positive := func(i int) bool { return i > 0 }
+	assert.Equal(t, Ok(1).IsOkAnd(positive), true)
+	assert.Equal(t, Ok(-1).IsOkAnd(positive), false)
+	assert.Equal(t, Err[int]("xxx").IsOkAnd(func(int) bool {
+		t.Fatal("pred called for Err")
+		return true
+	}), false)
+}
+
+func TestIsErrAnd(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	isSentinel := func(err error) bool { return errors.Is(err, sentinel) }
+	assert.Equal(t, Err[int](sentinel).IsErrAnd(isSentinel), true)
+	assert.Equal(t, Err[int]("xxx").IsErrAnd(isSentinel), false)
+	assert.Equal(t, Ok(1).IsErrAnd(func(error) bool {
+		t.Fatal("pred called for Ok")
+		return true
+	}), false)
+}
+
+func TestResultString(t *testing.T) {
+	assert.Equal(t, Ok(42).String(), "Ok(42)")
+	assert.Equal(t, Err[int]("boom").String(), "Err(boom)")
+	assert.Equal(t, fmt.Sprintf("%v", Ok("x")), "Ok(x)")
+	assert.Equal(t, fmt.Sprintf("%s", Err[int]("boom")), "Err(boom)")
+}
+
+func TestResultGoString(t *testing.T) {
+	assert.Equal(t, fmt.Sprintf("%#v", Ok(42)), "cement.Ok[int](42)")
+	assert.Equal(t, fmt.Sprintf("%#v", Ok("x")), `cement.Ok[string]("x")`)
+	assert.Equal(t, fmt.Sprintf("%#v", Err[[]int]("boom")), `cement.Err[[]int]("boom")`)
+}
+
+func TestFilter(t *testing.T) {
+	negative := errors.New("negative")
+	positive := func(i int) bool { return i >= 0 }
+	assert.Equal(t, Ok(1).Filter(positive, negative).Ok(), 1)
+	assert.Equal(t, Ok(-1).Filter(positive, negative).Err(), negative)
+	assert.Equal(t, Err[int]("xxx").Filter(func(int) bool {
+		t.Fatal("pred called for Err")
+		return true
+	}, negative).Err().Error(), "xxx")
+}
+
+func TestEnsure(t *testing.T) {
+	check := func(i int) error {
+		if i < 0 {
+			return fmt.Errorf("%d is negative", i)
+		}
+		return nil
+	}
+	assert.Equal(t, Ok(1).Ensure(check).Ok(), 1)
+	assert.Equal(t, Ok(-3).Ensure(check).Err().Error(), "-3 is negative")
+	assert.Equal(t, Err[int]("xxx").Ensure(func(int) error {
+		t.Fatal("check called for Err")
+		return nil
+	}).Err().Error(), "xxx")
+}
+
+func TestSeq(t *testing.T) {
+	assert.DeepEqual(t, slices.Collect(Ok(1).Seq()), []int{1})
+	assert.Equal(t, len(slices.Collect(Err[int]("xxx").Seq())), 0)
+
+	count := 0
+	for v := range Ok(2).Seq() {
+		assert.Equal(t, v, 2)
+		count++
+	}
+	assert.Equal(t, count, 1)
+}
+
+func TestSeq2(t *testing.T) {
+	count := 0
+	for v, err := range Ok(1).Seq2() {
+		assert.NilError(t, err)
+		assert.Equal(t, v, 1)
+		count++
+	}
+	for v, err := range Err[int]("xxx").Seq2() {
+		assert.Error(t, err, "xxx")
+		assert.Equal(t, v, 0)
+		count++
+	}
+	assert.Equal(t, count, 2)
+}
+
+func TestSwap(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	assert.Equal(t, Err[int](sentinel).Swap().Ok(), sentinel)
+	assert.Equal(t, Ok(42).Swap().Err().Error(), "expected error but got value: 42")
+}
+
+func TestExpect(t *testing.T) {
+	assert.Equal(t, Ok(1).Expect("config loaded"), 1)
+
+	sentinel := errors.New("file not found")
+	defer func() {
+		err, ok := recover().(error)
+		assert.Assert(t, ok)
+		assert.Equal(t, err.Error(), "config loaded: file not found")
+		assert.Assert(t, errors.Is(err, sentinel))
+	}()
+	Err[int](sentinel).Expect("config loaded")
+}
+
+func TestUnwrapOrZero(t *testing.T) {
+	assert.Equal(t, Ok(1).UnwrapOrZero(), 1)
+	assert.Equal(t, Err[int]("xxx").UnwrapOrZero(), 0)
+	assert.Assert(t, Err[map[string]int]("xxx").UnwrapOrZero() == nil)
+	assert.Equal(t, Err[struct{ A string }]("xxx").UnwrapOrZero(), struct{ A string }{})
+}
+
+func TestTap(t *testing.T) {
+	seen := []string{}
+	record := func(r Result[int]) { seen = append(seen, r.String()) }
+	inc := func(i int) int { return i + 1 }
+
+	result := Ok(1).Map(inc).Tap(record).Map(inc).Tap(record)
+	assert.Equal(t, result.Ok(), 3)
+	assert.DeepEqual(t, seen, []string{"Ok(2)", "Ok(3)"})
+
+	seen = []string{}
+	result = Err[int]("xxx").Tap(record).Map(inc)
+	assert.Equal(t, result.Err().Error(), "xxx")
+	assert.DeepEqual(t, seen, []string{"Err(xxx)"})
+}
+
+func TestWrapErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	result := Err[int](sentinel).WrapErr("loading %s from %d", "config", 42)
+	assert.Equal(t, result.Err().Error(), "loading config from 42: sentinel")
+	assert.Assert(t, errors.Is(result.Err(), sentinel))
+	assert.Equal(t, errors.Unwrap(result.Err()), sentinel)
+
+	assert.Equal(t, Err[int](sentinel).WrapErr("plain").Err().Error(), "plain: sentinel")
+	assert.Equal(t, Ok(1).WrapErr("unused %d", 1).Ok(), 1)
+}
+
+func TestOkPtr(t *testing.T) {
+	result := Ok(0)
+	p, err := result.OkPtr()
+	assert.NilError(t, err)
+	assert.Equal(t, *p, 0)
+	*p = 5
+	assert.Equal(t, result.Ok(), 0)
+
+	var nilMap map[string]int
+	pm, err := Ok(nilMap).OkPtr()
+	assert.NilError(t, err)
+	assert.Assert(t, pm != nil && *pm == nil)
+
+	p, err = Err[int]("xxx").OkPtr()
+	assert.Assert(t, p == nil)
+	assert.Error(t, err, "xxx")
+}
+
+func TestRecover(t *testing.T) {
+	notFound := errors.New("not found")
+	recoverNotFound := func(err error) Result[int] {
+		if errors.Is(err, notFound) {
+			return Ok(0)
+		}
+		return Err[int](fmt.Errorf("reclassified: %w", err))
+	}
+	assert.Equal(t, Err[int](notFound).Recover(recoverNotFound).Ok(), 0)
+	assert.Equal(t, Err[int]("xxx").Recover(recoverNotFound).Err().Error(), "reclassified: xxx")
+	assert.Equal(t, Ok(1).Recover(func(error) Result[int] {
+		t.Fatal("f called for Ok")
+		return Ok(0)
+	}).Ok(), 1)
+}
+
+func TestRecoverIf(t *testing.T) {
+	notFound := errors.New("not found")
+	isNotFound := func(err error) bool { return errors.Is(err, notFound) }
+	assert.Equal(t, Err[int](fmt.Errorf("row: %w", notFound)).RecoverIf(isNotFound, -1).Ok(), -1)
+
+	other := errors.New("other")
+	assert.Equal(t, Err[int](other).RecoverIf(isNotFound, -1).Err(), other)
+	assert.Equal(t, Ok(1).RecoverIf(isNotFound, -1).Ok(), 1)
+}
+
+func TestIsOkErrType(t *testing.T) {
+	var ok any = Ok(1)
+	var err any = Err[int]("xxx")
+	assert.Equal(t, IsOkType[int](ok), true)
+	assert.Equal(t, IsOkType[int](err), false)
+	assert.Equal(t, IsErrType[int](err), true)
+	assert.Equal(t, IsErrType[int](ok), false)
+
+	assert.Equal(t, IsOkType[int](44), false)
+	assert.Equal(t, IsErrType[int](errors.New("xxx")), false)
+	assert.Equal(t, IsOkType[string](ok), false)
+	assert.Equal(t, Is[int](ok) && Is[int](err), true)
+}
+
+func TestAsError(t *testing.T) {
+	assert.Assert(t, Ok(1).AsError() == nil)
+	sentinel := errors.New("sentinel")
+	assert.Equal(t, Err[int](sentinel).AsError(), sentinel)
+
+	adapter := func(r Result[int]) error {
+		if err := r.AsError(); err != nil {
+			return fmt.Errorf("adapter: %w", err)
+		}
+		return nil
+	}
+	assert.NilError(t, adapter(Ok(1)))
+	assert.Assert(t, errors.Is(adapter(Err[int](sentinel)), sentinel))
+}
+
+func TestOkOr(t *testing.T) {
+	missing := errors.New("missing")
+	m := map[string]int{"a": 1}
+	v, ok := m["a"]
+	assert.Equal(t, OkOr(v, ok, missing).Ok(), 1)
+	v, ok = m["b"]
+	assert.Equal(t, OkOr(v, ok, missing).Err(), missing)
+
+	result := OkOr(42, false, missing)
+	assert.Equal(t, result.Err(), missing)
+	assert.Equal(t, result.UnwrapOrZero(), 0)
+}
+
+func TestOkOrElse(t *testing.T) {
+	assert.Equal(t, OkOrElse(1, true, func() error {
+		t.Fatal("err built for ok")
+		return nil
+	}).Ok(), 1)
+	assert.Equal(t, OkOrElse(1, false, func() error { return errors.New("lazy") }).Err().Error(), "lazy")
+}
+
+func TestValue(t *testing.T) {
+	v, ok := Ok(1).Value()
+	assert.Equal(t, ok, true)
+	assert.Equal(t, v, 1)
+
+	s, ok := Err[string]("xxx").Value()
+	assert.Equal(t, ok, false)
+	assert.Equal(t, s, "")
+
+	p, ok := Err[*int]("xxx").Value()
+	assert.Equal(t, ok, false)
+	assert.Assert(t, p == nil)
+}
+
+func TestCatchAs(t *testing.T) {
+	r := Err[int](fmt.Errorf("wrapped: %w", &testError{code: 7}))
+	e, ok := CatchAs[int, *testError](r)
+	assert.Equal(t, ok, true)
+	assert.Equal(t, e.code, 7)
+
+	e, ok = CatchAs[int, *testError](Err[int]("plain"))
+	assert.Equal(t, ok, false)
+	assert.Assert(t, e == nil)
+
+	e, ok = CatchAs[int, *testError](Ok(1))
+	assert.Equal(t, ok, false)
+	assert.Assert(t, e == nil)
+}
+
+func TestWithDefault(t *testing.T) {
+	assert.Equal(t, Ok(1).WithDefault(9).Ok(), 1)
+	assert.Equal(t, Err[int]("xxx").WithDefault(9).Ok(), 9)
+	assert.Equal(t, Map(Err[int]("xxx").WithDefault(9), func(v int) int { return v + 1 }).Ok(), 10)
+	assert.Equal(t, ErrValue[int]("xxx").WithDefault(9).Ok(), 9)
+}
+
+func TestErrFrom(t *testing.T) {
+	var name string
+	r := ErrFrom(name, errors.New("boom"))
+	assert.Equal(t, r.Err().Error(), "boom")
+	assert.Equal(t, IsErrType[string](r), true)
+	assert.Assert(t, cmp.Panics(func() { ErrFrom(1, nil) }))
+}
+
+func TestSetErr(t *testing.T) {
+	boom := errors.New("boom")
+	r := Ok(1).WithMeta("req", "a").SetErr(boom)
+	assert.Equal(t, r.Err(), boom)
+	v, ok := r.Meta("req")
+	assert.Equal(t, ok, true)
+	assert.Equal(t, v, "a")
+
+	assert.Equal(t, Err[int]("old").SetErr(boom).Err(), boom)
+	assert.Equal(t, OkValue(1).SetErr(boom).Err(), boom)
+}
+
+func TestReplace(t *testing.T) {
+	assert.Equal(t, Ok(1).Replace(2).Ok(), 2)
+	r := Err[int]("xxx").Replace(2)
+	assert.Equal(t, r.IsErr(), true)
+	assert.Error(t, r.Err(), "xxx")
+	assert.Equal(t, OkValue(1).Replace(2).Ok(), 2)
+}
+
+func TestChain(t *testing.T) {
+	double := func(v int) Result[int] { return Ok(v * 2) }
+	inc := func(v int) Result[int] { return Ok(v + 1) }
+	assert.Equal(t, Ok(1).Chain(double, inc, double).Ok(), 6)
+	assert.Equal(t, Ok(1).Chain().Ok(), 1)
+
+	calls := 0
+	third := func(v int) Result[int] { calls++; return Ok(v) }
+	r := Ok(1).Chain(double, func(int) Result[int] { return Err[int]("second") }, third)
+	assert.Error(t, r.Err(), "second")
+	assert.Equal(t, calls, 0)
+
+	assert.Error(t, Err[int]("xxx").Chain(double).Err(), "xxx")
+	assert.Equal(t, OkValue(1).Chain(double).Ok(), 2)
+}
+
+func TestChainKeepsMeta(t *testing.T) {
+	r := Ok(1).WithMeta("req", "a").Chain(func(v int) Result[int] { return Ok(v + 1) })
+	v, ok := r.Meta("req")
+	assert.Equal(t, ok, true)
+	assert.Equal(t, v, "a")
+}
+
+func TestOr(t *testing.T) {
+	assert.Equal(t, Ok(1).Or(Ok(2)).Ok(), 1)
+	assert.Equal(t, Ok(1).Or(Err[int]("other")).Ok(), 1)
+	assert.Equal(t, Err[int]("xxx").Or(Ok(2)).Ok(), 2)
+	assert.Error(t, Err[int]("xxx").Or(Err[int]("other")).Err(), "other")
+	assert.Equal(t, ErrValue[int]("xxx").Or(Ok(2)).Ok(), 2)
+	assert.Equal(t, OkValue(1).Or(Ok(2)).Ok(), 1)
+}
+
+func TestFromPtr(t *testing.T) {
+	missing := errors.New("missing")
+	v := 42
+	assert.Equal(t, FromPtr(&v, missing).Ok(), 42)
+	assert.Equal(t, FromPtr[int](nil, missing).Err(), missing)
+}
+
+func TestToPtr(t *testing.T) {
+	r := Ok(42)
+	p := ToPtr(r)
+	assert.Equal(t, *p, 42)
+	*p = 7
+	assert.Equal(t, r.Ok(), 42)
+	assert.Equal(t, *ToPtr(r), 42)
+
+	assert.Assert(t, ToPtr(Err[int]("xxx")) == nil)
+}
+
+func TestUnwrapBoth(t *testing.T) {
+	v, err, ok := Ok(1).UnwrapBoth()
+	assert.Equal(t, v, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, ok, true)
+
+	s, err, ok := Err[string]("xxx").UnwrapBoth()
+	assert.Equal(t, s, "")
+	assert.Error(t, err, "xxx")
+	assert.Equal(t, ok, false)
+
+	n, err, ok := ErrValue[int]("xxx").UnwrapBoth()
+	assert.Equal(t, n, 0)
+	assert.Error(t, err, "xxx")
+	assert.Equal(t, ok, false)
+}
+
+func TestFinally(t *testing.T) {
+	calls := 0
+	done := func() { calls++ }
+	assert.Equal(t, Ok(1).Finally(done).Ok(), 1)
+	assert.Equal(t, calls, 1)
+
+	boom := errors.New("boom")
+	assert.Equal(t, Err[int](boom).Finally(done).Err(), boom)
+	assert.Equal(t, calls, 2)
+
+	assert.Equal(t, OkValue(1).Finally(done).Ok(), 1)
+	assert.Equal(t, calls, 3)
+}
+
+func TestErrNilPanicMessage(t *testing.T) {
+	defer func() {
+		assert.Equal(t, recover(), "Err must not be nil")
+	}()
+	Err[int](nil)
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, ResultError[int]{}.Normalize().Ok(), 0)
+	assert.Error(t, Err[int]("xxx").Normalize().Err(), "xxx")
+	assert.Equal(t, Ok(1).Normalize().Ok(), 1)
+	assert.Equal(t, OkValue(1).Normalize().Ok(), 1)
+}
+
+// TestResultErrorIsNotAnError pins that ResultError has no Error method.
+// With one, its Unwrap() T would become the errors.Unwrap hook, which must
+// return error; for T = error the signature would fit, and errors.Is would
+// then panic because Unwrap on an Err panics.
+func TestResultErrorIsNotAnError(t *testing.T) {
+	_, isErr := any(ResultError[int]{}).(error)
+	assert.Equal(t, isErr, false)
+	_, isErr = any(ResultError[error]{}).(error)
+	assert.Equal(t, isErr, false)
+	_, isErr = any(Err[error]("boom")).(error)
+	assert.Equal(t, isErr, false)
+}
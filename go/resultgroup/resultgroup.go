@@ -0,0 +1,97 @@
+// Package resultgroup is errgroup for cement Results: a Group runs
+// functions returning Result[T] and Wait collects their values in
+// submission order or reports the first error. It follows the semantics of
+// golang.org/x/sync/errgroup but only needs the standard library.
+package resultgroup
+
+import (
+	"context"
+	"sync"
+
+	cement "github.com/mabels/cement/go"
+)
+
+// Group is a collection of goroutines working on subtasks of the same
+// overall task. The zero Group is valid, has no limit and does not cancel
+// on error.
+type Group[T any] struct {
+	cancel func(error)
+	wg     sync.WaitGroup
+	sem    chan struct{}
+
+	mu     sync.Mutex
+	values []T
+	err    error
+}
+
+// WithContext returns a new Group and a derived Context. The derived
+// Context is canceled the first time a function passed to Go returns an
+// Err or the first time Wait returns, whichever occurs first.
+func WithContext[T any](ctx context.Context) (*Group[T], context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Group[T]{cancel: cancel}, ctx
+}
+
+// SetLimit limits the number of active goroutines to n; a negative n
+// removes the limit. It must not be called while goroutines are active.
+func (g *Group[T]) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// Go calls f in a new goroutine, blocking first if the limit is reached.
+// The value of f lands at the position of this call among all calls to Go.
+func (g *Group[T]) Go(f func() cement.Result[T]) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.mu.Lock()
+	i := len(g.values)
+	var zero T
+	g.values = append(g.values, zero)
+	g.mu.Unlock()
+
+	g.wg.Add(1)
+	go func() {
+		defer g.done()
+		r := f()
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		if r.IsErr() {
+			if g.err == nil {
+				g.err = r.Err()
+				if g.cancel != nil {
+					g.cancel(g.err)
+				}
+			}
+			return
+		}
+		g.values[i] = r.Ok()
+	}()
+}
+
+func (g *Group[T]) done() {
+	if g.sem != nil {
+		<-g.sem
+	}
+	g.wg.Done()
+}
+
+// Wait blocks until all functions passed to Go have returned, then returns
+// Ok with their values in submission order or the first Err.
+func (g *Group[T]) Wait() cement.Result[[]T] {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(g.err)
+	}
+	if g.err != nil {
+		return cement.Err[[]T](g.err)
+	}
+	if g.values == nil {
+		return cement.Ok([]T{})
+	}
+	return cement.Ok(g.values)
+}
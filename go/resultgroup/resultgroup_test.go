@@ -0,0 +1,78 @@
+package resultgroup
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cement "github.com/mabels/cement/go"
+	"gotest.tools/v3/assert"
+)
+
+func TestWaitPreservesSubmissionOrder(t *testing.T) {
+	g, _ := WithContext[int](context.Background())
+	for _, x := range []int{5, 4, 3, 2, 1, 0} {
+		g.Go(func() cement.Result[int] {
+			time.Sleep(time.Duration(x) * time.Millisecond)
+			return cement.Ok(x * 10)
+		})
+	}
+	assert.DeepEqual(t, g.Wait().Ok(), []int{50, 40, 30, 20, 10, 0})
+}
+
+func TestZeroGroup(t *testing.T) {
+	var g Group[string]
+	assert.DeepEqual(t, g.Wait().Ok(), []string{})
+	g.Go(func() cement.Result[string] { return cement.Ok("a") })
+	assert.DeepEqual(t, g.Wait().Ok(), []string{"a"})
+}
+
+func TestFirstErrorCancelsContext(t *testing.T) {
+	boom := errors.New("boom")
+	g, ctx := WithContext[int](context.Background())
+	g.Go(func() cement.Result[int] {
+		return cement.Err[int](boom)
+	})
+	g.Go(func() cement.Result[int] {
+		select {
+		case <-ctx.Done():
+			return cement.Err[int](ctx.Err())
+		case <-time.After(5 * time.Second):
+			return cement.Ok(1)
+		}
+	})
+	result := g.Wait()
+	assert.Equal(t, result.Err(), boom)
+	assert.Equal(t, context.Cause(ctx), boom)
+}
+
+func TestWaitCancelsContext(t *testing.T) {
+	g, ctx := WithContext[int](context.Background())
+	g.Go(func() cement.Result[int] { return cement.Ok(1) })
+	assert.Assert(t, g.Wait().IsOk())
+	assert.Equal(t, ctx.Err(), context.Canceled)
+}
+
+func TestSetLimit(t *testing.T) {
+	var running, peak atomic.Int32
+	g, _ := WithContext[int](context.Background())
+	g.SetLimit(2)
+	for i := range 10 {
+		g.Go(func() cement.Result[int] {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+			return cement.Ok(i)
+		})
+	}
+	assert.DeepEqual(t, g.Wait().Ok(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+	assert.Assert(t, peak.Load() <= 2)
+}
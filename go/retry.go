@@ -0,0 +1,39 @@
+package cement
+
+import (
+	"context"
+	"time"
+)
+
+// Retry calls f until it returns an Ok or attempts calls have been made,
+// returning the last Err. f is always called at least once.
+func Retry[T any](attempts int, f func() Result[T]) Result[T] {
+	r := f()
+	for i := 1; i < attempts && r.IsErr(); i++ {
+		r = f()
+	}
+	return r
+}
+
+// RetryWithBackoff is like Retry but sleeps base, 2*base, 4*base, ...
+// between attempts. It gives up with Err(ctx.Err()) as soon as ctx is done.
+func RetryWithBackoff[T any](ctx context.Context, attempts int, base time.Duration, f func() Result[T]) Result[T] {
+	delay := base
+	for i := 0; ; i++ {
+		if err := ctx.Err(); err != nil {
+			return Err[T](err)
+		}
+		r := f()
+		if r.IsOk() || i+1 >= attempts {
+			return r
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return Err[T](ctx.Err())
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
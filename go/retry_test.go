@@ -0,0 +1,72 @@
+package cement
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func succeedOn(n int, calls *int) func() Result[int] {
+	return func() Result[int] {
+		*calls++
+		if *calls < n {
+			return Err[int](errors.New("not yet"))
+		}
+		return Ok(*calls)
+	}
+}
+
+func TestRetrySucceedsOnThird(t *testing.T) {
+	calls := 0
+	result := Retry(5, succeedOn(3, &calls))
+	assert.Equal(t, result.Ok(), 3)
+	assert.Equal(t, calls, 3)
+}
+
+func TestRetryAlwaysFails(t *testing.T) {
+	calls := 0
+	result := Retry(4, func() Result[int] {
+		calls++
+		return Err[int](errors.New("fail"))
+	})
+	assert.Equal(t, result.Err().Error(), "fail")
+	assert.Equal(t, calls, 4)
+}
+
+func TestRetryCallsAtLeastOnce(t *testing.T) {
+	calls := 0
+	Retry(0, succeedOn(1, &calls))
+	assert.Equal(t, calls, 1)
+}
+
+func TestRetryWithBackoffSucceedsOnThird(t *testing.T) {
+	calls := 0
+	result := RetryWithBackoff(context.Background(), 5, time.Millisecond, succeedOn(3, &calls))
+	assert.Equal(t, result.Ok(), 3)
+	assert.Equal(t, calls, 3)
+}
+
+func TestRetryWithBackoffAlwaysFails(t *testing.T) {
+	calls := 0
+	result := RetryWithBackoff(context.Background(), 3, time.Millisecond, func() Result[int] {
+		calls++
+		return Err[int](errors.New("fail"))
+	})
+	assert.Equal(t, result.Err().Error(), "fail")
+	assert.Equal(t, calls, 3)
+}
+
+func TestRetryWithBackoffCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	result := RetryWithBackoff(ctx, 5, time.Hour, func() Result[int] {
+		calls++
+		cancel()
+		return Err[int](errors.New("fail"))
+	})
+	assert.Equal(t, result.Err(), context.Canceled)
+	assert.Equal(t, calls, 1)
+}
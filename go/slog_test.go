@@ -0,0 +1,45 @@
+package cement
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+type testUser struct {
+	name     string
+	password string
+}
+
+func (u testUser) LogValue() slog.Value {
+	return slog.StringValue(u.name)
+}
+
+func logJSON(args ...any) string {
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("msg", args...)
+	return buf.String()
+}
+
+func TestLogValueOk(t *testing.T) {
+	assert.Equal(t, logJSON("result", Ok(42)), `{"msg":"msg","result":{"ok":42}}`+"\n")
+}
+
+func TestLogValueErr(t *testing.T) {
+	assert.Equal(t, logJSON("result", Err[int]("boom")), `{"msg":"msg","result":{"error":"boom"}}`+"\n")
+}
+
+func TestLogValueDelegates(t *testing.T) {
+	result := Ok(testUser{name: "bob", password: "secret"})
+	assert.Equal(t, logJSON("result", result), `{"msg":"msg","result":{"ok":"bob"}}`+"\n")
+}
@@ -0,0 +1,45 @@
+// Package sqlx scans database/sql rows into cement Results.
+package sqlx
+
+import (
+	"database/sql"
+
+	cement "github.com/mabels/cement/go"
+)
+
+// ScanOne scans the first row of rows with scan and closes rows. No rows
+// at all yields Err(sql.ErrNoRows), or the error that ended iteration
+// early.
+func ScanOne[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) cement.Result[T] {
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return cement.Err[T](err)
+		}
+		return cement.Err[T](sql.ErrNoRows)
+	}
+	t, err := scan(rows)
+	if err != nil {
+		return cement.Err[T](err)
+	}
+	return cement.From(t, rows.Close())
+}
+
+// ScanAll scans every row of rows with scan and closes rows. It stops at
+// the first scan error; an error reported by rows.Err after iteration is
+// returned as well. Zero rows is Ok with an empty slice.
+func ScanAll[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) cement.Result[[]T] {
+	defer rows.Close()
+	out := []T{}
+	for rows.Next() {
+		t, err := scan(rows)
+		if err != nil {
+			return cement.Err[[]T](err)
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return cement.Err[[]T](err)
+	}
+	return cement.From(out, rows.Close())
+}
@@ -0,0 +1,127 @@
+package sqlx
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+var errBrokenRows = errors.New("connection reset")
+
+// fakeDriver serves canned integer rows keyed by the query text.
+type fakeDriver struct{}
+
+type fakeConn struct{}
+
+type fakeStmt struct{ query string }
+
+type fakeRows struct {
+	vals []int64
+	err  error
+	i    int
+}
+
+var fakeTables = map[string]fakeRows{
+	"empty":  {},
+	"three":  {vals: []int64{1, 2, 3}},
+	"broken": {vals: []int64{1, 2}, err: errBrokenRows},
+	"failed": {err: errBrokenRows},
+}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{query: query}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("no transactions") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return 0 }
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("no exec")
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	rows, ok := fakeTables[s.query]
+	if !ok {
+		return nil, errors.New("unknown table " + s.query)
+	}
+	return &rows, nil
+}
+
+func (r *fakeRows) Columns() []string { return []string{"n"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.vals) {
+		if r.err != nil {
+			return r.err
+		}
+		return io.EOF
+	}
+	dest[0] = r.vals[r.i]
+	r.i++
+	return nil
+}
+
+func init() {
+	sql.Register("cementfake", fakeDriver{})
+}
+
+func query(t *testing.T, table string) *sql.Rows {
+	t.Helper()
+	db, err := sql.Open("cementfake", "")
+	assert.NilError(t, err)
+	t.Cleanup(func() { db.Close() })
+	rows, err := db.Query(table)
+	assert.NilError(t, err)
+	return rows
+}
+
+func scanInt(rows *sql.Rows) (int, error) {
+	var n int
+	err := rows.Scan(&n)
+	return n, err
+}
+
+func TestScanOne(t *testing.T) {
+	assert.Equal(t, ScanOne(query(t, "three"), scanInt).Ok(), 1)
+	assert.Equal(t, ScanOne(query(t, "empty"), scanInt).Err(), sql.ErrNoRows)
+	assert.Equal(t, ScanOne(query(t, "failed"), scanInt).Err(), errBrokenRows)
+
+	scanErr := errors.New("bad row")
+	r := ScanOne(query(t, "three"), func(*sql.Rows) (int, error) { return 0, scanErr })
+	assert.Equal(t, r.Err(), scanErr)
+}
+
+func TestScanOneClosesRows(t *testing.T) {
+	rows := query(t, "three")
+	ScanOne(rows, scanInt)
+	assert.Equal(t, rows.Next(), false)
+}
+
+func TestScanAll(t *testing.T) {
+	assert.DeepEqual(t, ScanAll(query(t, "three"), scanInt).Ok(), []int{1, 2, 3})
+	assert.DeepEqual(t, ScanAll(query(t, "empty"), scanInt).Ok(), []int{})
+}
+
+func TestScanAllScanErrorMidIteration(t *testing.T) {
+	scanErr := errors.New("bad row")
+	calls := 0
+	r := ScanAll(query(t, "three"), func(rows *sql.Rows) (int, error) {
+		calls++
+		if calls == 2 {
+			return 0, scanErr
+		}
+		return scanInt(rows)
+	})
+	assert.Equal(t, r.Err(), scanErr)
+	assert.Equal(t, calls, 2)
+}
+
+func TestScanAllRowsErr(t *testing.T) {
+	assert.Equal(t, ScanAll(query(t, "broken"), scanInt).Err(), errBrokenRows)
+}
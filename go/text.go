@@ -0,0 +1,67 @@
+package cement
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+)
+
+// Text encoding: an Ok is written as the text form of its value, an Err as
+// "!err:" followed by the message. To keep the two apart, an Ok text that
+// starts with "!" gets one more "!" in front, which decoding strips again.
+const textErrPrefix = "!err:"
+
+// MarshalText requires T to implement encoding.TextMarshaler.
+func (r ResultOK[T]) MarshalText() ([]byte, error) {
+	m, ok := any(r.t).(encoding.TextMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("Result MarshalText: %T does not implement encoding.TextMarshaler", r.t)
+	}
+	text, err := m.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	if len(text) > 0 && text[0] == '!' {
+		text = append([]byte{'!'}, text...)
+	}
+	return text, nil
+}
+
+func (r ResultError[T]) MarshalText() ([]byte, error) {
+	return []byte(textErrPrefix + r.t.Error()), nil
+}
+
+// UnmarshalText decodes the format written by MarshalText. *T must
+// implement encoding.TextUnmarshaler.
+func UnmarshalText[T any](text []byte) Result[T] {
+	if msg, ok := bytes.CutPrefix(text, []byte(textErrPrefix)); ok {
+		return Err[T](string(msg))
+	}
+	if len(text) > 0 && text[0] == '!' {
+		text = text[1:]
+	}
+	var t T
+	u, ok := any(&t).(encoding.TextUnmarshaler)
+	if !ok {
+		return Err[T](fmt.Errorf("Result UnmarshalText: %T does not implement encoding.TextUnmarshaler", &t))
+	}
+	if err := u.UnmarshalText(text); err != nil {
+		return Err[T](err)
+	}
+	return Ok(t)
+}
+
+func (r ResultValue[T]) MarshalText() ([]byte, error) {
+	return r.Box().(encoding.TextMarshaler).MarshalText()
+}
+
+// UnmarshalText lets a ResultValue be decoded in place, e.g. as a map key
+// or a flag value. Decoding problems are returned, not stored in r.
+func (r *ResultValue[T]) UnmarshalText(text []byte) error {
+	res := UnmarshalText[T](text)
+	if res.IsErr() && !bytes.HasPrefix(text, []byte(textErrPrefix)) {
+		return res.Err()
+	}
+	*r = Unbox(res)
+	return nil
+}
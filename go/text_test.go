@@ -0,0 +1,75 @@
+package cement
+
+import (
+	"encoding"
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+type textWord string
+
+func (w textWord) MarshalText() ([]byte, error) {
+	return []byte(w), nil
+}
+
+func (w *textWord) UnmarshalText(text []byte) error {
+	if strings.Contains(string(text), " ") {
+		return errors.New("word contains a space")
+	}
+	*w = textWord(text)
+	return nil
+}
+
+func marshalText(r Result[textWord]) string {
+	text, err := r.(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		return "marshal failed: " + err.Error()
+	}
+	return string(text)
+}
+
+func TestMarshalText(t *testing.T) {
+	assert.Equal(t, marshalText(Ok(textWord("hello"))), "hello")
+	assert.Equal(t, marshalText(Err[textWord]("boom")), "!err:boom")
+	assert.Equal(t, marshalText(Ok(textWord("!err:x"))), "!!err:x")
+	assert.Equal(t, marshalText(Ok(textWord("!bang"))), "!!bang")
+
+	_, err := Ok(1).(encoding.TextMarshaler).MarshalText()
+	assert.ErrorContains(t, err, "int does not implement encoding.TextMarshaler")
+}
+
+func TestUnmarshalText(t *testing.T) {
+	assert.Equal(t, UnmarshalText[textWord]([]byte("hello")).Ok(), textWord("hello"))
+	assert.Equal(t, UnmarshalText[textWord]([]byte("!err:boom")).Err().Error(), "boom")
+	assert.Equal(t, UnmarshalText[textWord]([]byte("has space")).Err().Error(), "word contains a space")
+	assert.ErrorContains(t, UnmarshalText[int]([]byte("1")).Err(), "does not implement encoding.TextUnmarshaler")
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	for _, w := range []textWord{"plain", "!err:tricky", "!!double", "!", ""} {
+		text := marshalText(Ok(w))
+		assert.Equal(t, UnmarshalText[textWord]([]byte(text)).Ok(), w, text)
+	}
+	text := marshalText(Err[textWord]("boom"))
+	assert.Equal(t, UnmarshalText[textWord]([]byte(text)).Err().Error(), "boom")
+
+	addr := netip.MustParseAddr("10.0.0.1")
+	data, err := OkValue(addr).MarshalText()
+	assert.NilError(t, err)
+	assert.Equal(t, UnmarshalText[netip.Addr](data).Ok(), addr)
+}
+
+func TestResultValueUnmarshalText(t *testing.T) {
+	var r ResultValue[textWord]
+	assert.NilError(t, r.UnmarshalText([]byte("hello")))
+	assert.Equal(t, r.Ok(), textWord("hello"))
+
+	assert.NilError(t, r.UnmarshalText([]byte("!err:boom")))
+	assert.Equal(t, r.Err().Error(), "boom")
+
+	assert.Error(t, r.UnmarshalText([]byte("has space")), "word contains a space")
+}
@@ -0,0 +1,62 @@
+package cement
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+const maxTraceDepth = 32
+
+// tracedError carries the program counters of the ErrTrace call. It
+// unwraps to the original error and keeps its message, and because it
+// travels with the error it survives Map, AndThen, WrapErr and the like.
+type tracedError struct {
+	err error
+	pcs []uintptr
+}
+
+func (e *tracedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *tracedError) Unwrap() error {
+	return e.err
+}
+
+// ErrTrace is Err that also records the call stack at the point of
+// construction, retrievable with StackTrace. Capturing costs a
+// runtime.Callers call, so it is opt-in. The error is wrapped, so compare
+// it with errors.Is rather than ==.
+func ErrTrace[T any](t any) Result[T] {
+	err := Err[T](t).Err()
+	pcs := make([]uintptr, maxTraceDepth)
+	n := runtime.Callers(2, pcs)
+	return ResultError[T]{t: &tracedError{err: err, pcs: pcs[:n]}}
+}
+
+func stackTrace(err error) []uintptr {
+	var traced *tracedError
+	if errors.As(err, &traced) {
+		return traced.pcs
+	}
+	return nil
+}
+
+// FormatStack renders pcs as returned by StackTrace in the style of a
+// goroutine dump: the function, then its file:line indented below.
+func FormatStack(pcs []uintptr) string {
+	var sb strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" {
+			fmt.Fprintf(&sb, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			break
+		}
+	}
+	return sb.String()
+}
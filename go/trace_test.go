@@ -0,0 +1,44 @@
+package cement
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestErrTrace(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	r := ErrTrace[int](sentinel)
+	assert.Error(t, r.Err(), "sentinel")
+	assert.Assert(t, errors.Is(r.Err(), sentinel))
+
+	pcs := r.StackTrace()
+	assert.Assert(t, len(pcs) > 0)
+	frame, _ := runtime.CallersFrames(pcs).Next()
+	assert.Assert(t, strings.HasSuffix(frame.Function, ".TestErrTrace"), frame.Function)
+	assert.Assert(t, strings.HasSuffix(frame.File, "trace_test.go"), frame.File)
+}
+
+func TestErrTraceSurvivesCombinators(t *testing.T) {
+	r := ErrTrace[int]("boom")
+	mapped := AndThen(Map(r, func(v int) string { return "x" }), func(s string) Result[bool] { return Ok(true) })
+	assert.DeepEqual(t, mapped.StackTrace(), r.StackTrace())
+	assert.DeepEqual(t, r.WrapErr("ctx").StackTrace(), r.StackTrace())
+	assert.DeepEqual(t, Unbox(r).StackTrace(), r.StackTrace())
+}
+
+func TestStackTraceWithoutTrace(t *testing.T) {
+	assert.Assert(t, Err[int]("boom").StackTrace() == nil)
+	assert.Assert(t, Ok(1).StackTrace() == nil)
+}
+
+func TestFormatStack(t *testing.T) {
+	s := FormatStack(ErrTrace[int]("boom").StackTrace())
+	first, _, _ := strings.Cut(s, "\n")
+	assert.Assert(t, strings.HasSuffix(first, ".TestFormatStack"), s)
+	assert.Assert(t, strings.Contains(s, "trace_test.go:"), s)
+	assert.Equal(t, FormatStack(nil), "")
+}
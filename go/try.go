@@ -0,0 +1,75 @@
+package cement
+
+import (
+	"errors"
+	"fmt"
+)
+
+func panicToError(p any) error {
+	switch v := p.(type) {
+	case error:
+		return v
+	case string:
+		return errors.New(v)
+	default:
+		return fmt.Errorf("panic: %v", v)
+	}
+}
+
+// Try runs f and returns its value as Ok. A panic inside f is recovered
+// into an Err: an error value is kept as is, a string becomes its message
+// and anything else is formatted with %v.
+func Try[T any](f func() T) (res Result[T]) {
+	defer func() {
+		if p := recover(); p != nil {
+			res = Err[T](panicToError(p))
+		}
+	}()
+	return Ok(f())
+}
+
+// Guard runs f and lifts its (T, error) return into a Result, recovering
+// any panic into an Err the same way Try does. A recovered panic always
+// wins over a returned value.
+func Guard[T any](f func() (T, error)) (res Result[T]) {
+	defer func() {
+		if p := recover(); p != nil {
+			res = Err[T](panicToError(p))
+		}
+	}()
+	return From(f())
+}
+
+type bailout struct {
+	err error
+}
+
+// Do runs body and returns its value as Ok. Calling bail, or Check on an
+// Err, inside body exits it early and Do returns that error. Like Check on
+// an Ok, bail(nil) does nothing, so bail(f.Close()) reads naturally. Only
+// these early exits are recovered; any other panic propagates.
+func Do[T any](body func(bail func(error)) T) (res Result[T]) {
+	defer func() {
+		if p := recover(); p != nil {
+			b, ok := p.(bailout)
+			if !ok {
+				panic(p)
+			}
+			res = Err[T](b.err)
+		}
+	}()
+	return Ok(body(func(err error) {
+		if err != nil {
+			panic(bailout{err: err})
+		}
+	}))
+}
+
+// Check returns the Ok value of r or exits the enclosing Do with r's
+// error. It must only be called inside a Do body.
+func Check[T any](r Result[T]) T {
+	if r.IsErr() {
+		panic(bailout{err: r.Err()})
+	}
+	return r.Ok()
+}
@@ -0,0 +1,111 @@
+package cement
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTryOk(t *testing.T) {
+	result := Try(func() int { return 42 })
+	assert.Equal(t, result.Ok(), 42)
+}
+
+func TestTryPanicError(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	result := Try(func() int { panic(sentinel) })
+	assert.Equal(t, result.Err(), sentinel)
+}
+
+func TestTryPanicString(t *testing.T) {
+	result := Try(func() int { panic("boom") })
+	assert.Equal(t, result.Err().Error(), "boom")
+}
+
+func TestTryPanicOther(t *testing.T) {
+	result := Try(func() int { panic(42) })
+	assert.Equal(t, result.Err().Error(), "panic: 42")
+}
+
+func TestTryRuntimePanic(t *testing.T) {
+	result := Try(func() int {
+		var xs []int
+		return xs[1]
+	})
+	assert.Equal(t, result.IsErr(), true)
+	assert.ErrorContains(t, result.Err(), "index out of range")
+}
+
+func TestGuardOk(t *testing.T) {
+	assert.Equal(t, Guard(func() (int, error) { return 1, nil }).Ok(), 1)
+}
+
+func TestGuardReturnError(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	assert.Equal(t, Guard(func() (int, error) { return 1, sentinel }).Err(), sentinel)
+}
+
+func TestGuardPanicError(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	assert.Equal(t, Guard(func() (int, error) { panic(sentinel) }).Err(), sentinel)
+}
+
+func TestGuardPanicString(t *testing.T) {
+	assert.Equal(t, Guard(func() (int, error) { panic("boom") }).Err().Error(), "boom")
+}
+
+func TestGuardDeferredPanicWins(t *testing.T) {
+	result := Guard(func() (int, error) {
+		defer func() { panic("late") }()
+		return 1, nil
+	})
+	assert.Equal(t, result.Err().Error(), "late")
+}
+
+func TestDoOk(t *testing.T) {
+	result := Do(func(bail func(error)) int {
+		a := Check(Ok(1))
+		b := Check(Ok(2))
+		return a + b
+	})
+	assert.Equal(t, result.Ok(), 3)
+}
+
+func TestDoCheckErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	reached := false
+	result := Do(func(bail func(error)) int {
+		a := Check(Ok(1))
+		b := Check(Err[int](sentinel))
+		reached = true
+		return a + b
+	})
+	assert.Equal(t, result.Err(), sentinel)
+	assert.Equal(t, reached, false)
+}
+
+func TestDoBail(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	result := Do(func(bail func(error)) string {
+		bail(sentinel)
+		return "unreachable"
+	})
+	assert.Equal(t, result.Err(), sentinel)
+}
+
+func TestDoPropagatesOtherPanics(t *testing.T) {
+	defer func() {
+		assert.Equal(t, recover(), "boom")
+	}()
+	Do(func(bail func(error)) int { panic("boom") })
+	t.Fatal("expected panic")
+}
+
+func TestDoBailNil(t *testing.T) {
+	result := Do(func(bail func(error)) int {
+		bail(nil)
+		return 1
+	})
+	assert.Equal(t, result.Ok(), 1)
+}
@@ -0,0 +1,46 @@
+package cement
+
+type Tuple2[A, B any] struct {
+	First  A
+	Second B
+}
+
+type Tuple3[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Result2 holds two values or an error, for wrapping (A, B, error)
+// returns. It is a defined interface rather than an alias of
+// Result[Tuple2[A, B]] so it needs no newer Go than the rest of the
+// module; every Result[Tuple2[A, B]] satisfies it and vice versa.
+type Result2[A, B any] interface {
+	Result[Tuple2[A, B]]
+}
+
+func Ok2[A, B any](a A, b B) Result2[A, B] {
+	return Ok(Tuple2[A, B]{First: a, Second: b})
+}
+
+// From2 lifts an (A, B, error) return into a Result2.
+func From2[A, B any](a A, b B, err error) Result2[A, B] {
+	if err != nil {
+		return Err[Tuple2[A, B]](err)
+	}
+	return Ok2(a, b)
+}
+
+// Split2 hands r back as (A, B, error), with zero values alongside an
+// error.
+func Split2[A, B any](r Result[Tuple2[A, B]]) (A, B, error) {
+	t, err := r.Split()
+	return t.First, t.Second, err
+}
+
+// Unzip splits r into its two components. An Err yields two Errs holding
+// the same error value.
+func Unzip[A, B any](r Result[Tuple2[A, B]]) (Result[A], Result[B]) {
+	return Map(r, func(t Tuple2[A, B]) A { return t.First }),
+		Map(r, func(t Tuple2[A, B]) B { return t.Second })
+}
@@ -0,0 +1,58 @@
+package cement
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestOk2(t *testing.T) {
+	a, b, err := Split2(Ok2("x", 1))
+	assert.NilError(t, err)
+	assert.Equal(t, a, "x")
+	assert.Equal(t, b, 1)
+}
+
+func TestFrom2(t *testing.T) {
+	lookup := func(m map[string]int, k string) (string, int, error) {
+		v, ok := m[k]
+		if !ok {
+			return "ignored", 99, errors.New("missing " + k)
+		}
+		return k, v, nil
+	}
+	m := map[string]int{"a": 1}
+
+	r := From2(lookup(m, "a"))
+	assert.Equal(t, r.Ok(), Tuple2[string, int]{First: "a", Second: 1})
+
+	r = From2(lookup(m, "b"))
+	a, b, err := Split2(r)
+	assert.Error(t, err, "missing b")
+	assert.Equal(t, a, "")
+	assert.Equal(t, b, 0)
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip(Zip(Ok("x"), Ok(1)))
+	assert.Equal(t, a.Ok(), "x")
+	assert.Equal(t, b.Ok(), 1)
+
+	sentinel := errors.New("sentinel")
+	a, b = Unzip(Err[Tuple2[string, int]](sentinel))
+	assert.Equal(t, a.Err(), sentinel)
+	assert.Equal(t, b.Err(), sentinel)
+	assert.Equal(t, a.Err(), b.Err())
+}
+
+func TestResult2Interop(t *testing.T) {
+	var r Result2[string, int] = Ok2("x", 1)
+	var plain Result[Tuple2[string, int]] = r
+	assert.Equal(t, IsOkType[Tuple2[string, int]](plain), true)
+
+	first := Map(r, func(t Tuple2[string, int]) string { return t.First })
+	assert.Equal(t, first.Ok(), "x")
+	_, second := Unzip(r)
+	assert.Equal(t, second.Ok(), 1)
+}
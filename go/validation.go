@@ -0,0 +1,75 @@
+package cement
+
+import "errors"
+
+var errInvalid = errors.New("invalid")
+
+// Validation is like Result but accumulates every error instead of
+// stopping at the first one.
+type Validation[T any] struct {
+	t     T
+	errs  []error
+	valid bool
+}
+
+func Valid[T any](t T) Validation[T] {
+	return Validation[T]{t: t, valid: true}
+}
+
+// Invalid builds a failed Validation. Without errs it carries a generic
+// "invalid" error so ToResult always has something to report.
+func Invalid[T any](errs ...error) Validation[T] {
+	if len(errs) == 0 {
+		errs = []error{errInvalid}
+	}
+	return Validation[T]{errs: errs}
+}
+
+func (v Validation[T]) IsValid() bool {
+	return v.valid
+}
+
+func (v Validation[T]) IsInvalid() bool {
+	return !v.IsValid()
+}
+
+func (v Validation[T]) Value() T {
+	if v.IsInvalid() {
+		panic("Validation is Invalid")
+	}
+	return v.t
+}
+
+func (v Validation[T]) Errors() []error {
+	return v.errs
+}
+
+// ToResult downgrades v to a Result, joining the accumulated errors. A
+// single error is passed through as is.
+func (v Validation[T]) ToResult() Result[T] {
+	if v.IsInvalid() {
+		if len(v.errs) == 1 {
+			return Err[T](v.errs[0])
+		}
+		return Err[T](errors.Join(v.errs...))
+	}
+	return Ok(v.t)
+}
+
+// ValidateAll is Valid with every value if all vs are Valid, otherwise
+// Invalid with the errors of every invalid entry in order.
+func ValidateAll[T any](vs ...Validation[T]) Validation[[]T] {
+	out := make([]T, 0, len(vs))
+	var errs []error
+	for _, v := range vs {
+		if v.IsInvalid() {
+			errs = append(errs, v.errs...)
+			continue
+		}
+		out = append(out, v.t)
+	}
+	if len(errs) > 0 {
+		return Invalid[[]T](errs...)
+	}
+	return Valid(out)
+}
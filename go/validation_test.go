@@ -0,0 +1,43 @@
+package cement
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func TestValidation(t *testing.T) {
+	v := Valid(1)
+	assert.Equal(t, v.IsValid(), true)
+	assert.Equal(t, v.Value(), 1)
+	assert.Equal(t, v.ToResult().Ok(), 1)
+
+	errA := errors.New("a")
+	inv := Invalid[int](errA)
+	assert.Equal(t, inv.IsInvalid(), true)
+	assert.Equal(t, len(inv.Errors()), 1)
+	assert.Equal(t, inv.Errors()[0], errA)
+	assert.Assert(t, cmp.Panics(func() { inv.Value() }))
+	assert.Equal(t, inv.ToResult().Err(), errA)
+
+	assert.Equal(t, Invalid[int]().ToResult().Err().Error(), "invalid")
+}
+
+func TestValidateAll(t *testing.T) {
+	assert.DeepEqual(t, ValidateAll(Valid(1), Valid(2)).Value(), []int{1, 2})
+	assert.Equal(t, len(ValidateAll[int]().Value()), 0)
+
+	name := errors.New("name required")
+	age := errors.New("age must be positive")
+	email := errors.New("email invalid")
+	v := ValidateAll(Invalid[string](name), Valid("ok"), Invalid[string](age, email))
+	assert.Equal(t, v.IsInvalid(), true)
+	assert.Equal(t, len(v.Errors()), 3)
+	err := v.ToResult().Err()
+	assert.Assert(t, errors.Is(err, name))
+	assert.Assert(t, errors.Is(err, age))
+	assert.Assert(t, errors.Is(err, email))
+	assert.Equal(t, err.Error(), "name required\nage must be positive\nemail invalid")
+}
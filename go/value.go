@@ -0,0 +1,308 @@
+package cement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"reflect"
+)
+
+// ResultValue is a Result in a single struct instead of an interface, for
+// hot paths where boxing every Result into the interface shows up as
+// allocations. It implements Result[T] itself. The accessors work on the
+// struct directly and do not allocate; methods returning a Result go
+// through Box. The zero ResultValue is Ok with the zero T.
+type ResultValue[T any] struct {
+	t    T
+	err  error
+	meta *metaEntry
+}
+
+var _ Result[int] = ResultValue[int]{}
+
+func OkValue[T any](t T) ResultValue[T] {
+	return ResultValue[T]{t: t}
+}
+
+// ErrValue accepts the same arguments as Err.
+func ErrValue[T any](t any) ResultValue[T] {
+	return ResultValue[T]{err: Err[T](t).Err()}
+}
+
+// Unbox converts any Result into its value form.
+func Unbox[T any](r Result[T]) ResultValue[T] {
+	if v, ok := r.(ResultValue[T]); ok {
+		return v
+	}
+	if r.IsErr() {
+		return ResultValue[T]{err: r.Err(), meta: metaOf(r)}
+	}
+	return ResultValue[T]{t: r.Ok(), meta: metaOf(r)}
+}
+
+// Box converts r into the interface variants ResultOK or ResultError.
+func (r ResultValue[T]) Box() Result[T] {
+	if r.err != nil {
+		return ResultError[T]{t: r.err, meta: r.meta}
+	}
+	return ResultOK[T]{t: r.t, meta: r.meta}
+}
+
+func (r ResultValue[T]) IsOk() bool {
+	return r.err == nil
+}
+
+func (r ResultValue[T]) IsErr() bool {
+	return !r.IsOk()
+}
+
+func (r ResultValue[T]) Err() error {
+	if r.IsOk() {
+		panic("Result is Ok")
+	}
+	return r.err
+}
+
+func (r ResultValue[T]) Ok() T {
+	if r.IsErr() {
+		panic(fmt.Errorf("Result is Err:%v", r.err.Error()))
+	}
+	return r.t
+}
+
+func (r ResultValue[T]) Unwrap() T {
+	return r.Ok()
+}
+
+func (r ResultValue[T]) UnwrapErr() error {
+	return r.Err()
+}
+
+func (r ResultValue[T]) Map(f func(T) T) Result[T] {
+	return r.Box().Map(f)
+}
+
+func (r ResultValue[T]) UnwrapOr(def T) T {
+	if r.IsErr() {
+		return def
+	}
+	return r.t
+}
+
+func (r ResultValue[T]) UnwrapOrElse(f func(error) T) T {
+	if r.IsErr() {
+		return f(r.err)
+	}
+	return r.t
+}
+
+func (r ResultValue[T]) Split() (T, error) {
+	return r.t, r.err
+}
+
+func (r ResultValue[T]) Fold(onOk func(T) T, onErr func(error) T) T {
+	if r.IsErr() {
+		return onErr(r.err)
+	}
+	return onOk(r.t)
+}
+
+func (r ResultValue[T]) Is(target error) bool {
+	return r.IsErr() && errors.Is(r.err, target)
+}
+
+func (r ResultValue[T]) As(target any) bool {
+	return r.IsErr() && errors.As(r.err, target)
+}
+
+func (r ResultValue[T]) MapErr(f func(error) error) Result[T] {
+	return r.Box().MapErr(f)
+}
+
+func (r ResultValue[T]) OrElse(f func(error) Result[T]) Result[T] {
+	return r.Box().OrElse(f)
+}
+
+func (r ResultValue[T]) Inspect(f func(T)) Result[T] {
+	if r.IsOk() {
+		f(r.t)
+	}
+	return r
+}
+
+func (r ResultValue[T]) InspectErr(f func(error)) Result[T] {
+	if r.IsErr() {
+		f(r.err)
+	}
+	return r
+}
+
+func (r ResultValue[T]) IsOkAnd(pred func(T) bool) bool {
+	return r.IsOk() && pred(r.t)
+}
+
+func (r ResultValue[T]) IsErrAnd(pred func(error) bool) bool {
+	return r.IsErr() && pred(r.err)
+}
+
+func (r ResultValue[T]) String() string {
+	return r.Box().String()
+}
+
+func (r ResultValue[T]) GoString() string {
+	if r.IsErr() {
+		return fmt.Sprintf("cement.ErrValue[%s](%q)", reflect.TypeFor[T](), r.err.Error())
+	}
+	return fmt.Sprintf("cement.OkValue[%s](%#v)", reflect.TypeFor[T](), r.t)
+}
+
+func (r ResultValue[T]) LogValue() slog.Value {
+	return r.Box().LogValue()
+}
+
+func (r ResultValue[T]) Filter(pred func(T) bool, err error) Result[T] {
+	return r.Box().Filter(pred, err)
+}
+
+func (r ResultValue[T]) Ensure(check func(T) error) Result[T] {
+	return r.Box().Ensure(check)
+}
+
+func (r ResultValue[T]) Seq() iter.Seq[T] {
+	return r.Box().Seq()
+}
+
+func (r ResultValue[T]) Seq2() iter.Seq2[T, error] {
+	return r.Box().Seq2()
+}
+
+func (r ResultValue[T]) Swap() Result[error] {
+	return r.Box().Swap()
+}
+
+func (r ResultValue[T]) Expect(msg string) T {
+	if r.IsErr() {
+		panic(fmt.Errorf("%s: %w", msg, r.err))
+	}
+	return r.t
+}
+
+func (r ResultValue[T]) UnwrapOrZero() T {
+	return r.t
+}
+
+func (r ResultValue[T]) Tap(f func(Result[T])) Result[T] {
+	f(r)
+	return r
+}
+
+func (r ResultValue[T]) WrapErr(format string, args ...any) Result[T] {
+	return r.Box().WrapErr(format, args...)
+}
+
+func (r ResultValue[T]) OkPtr() (*T, error) {
+	return r.Box().OkPtr()
+}
+
+func (r ResultValue[T]) Recover(f func(error) Result[T]) Result[T] {
+	return r.Box().Recover(f)
+}
+
+func (r ResultValue[T]) RecoverIf(match func(error) bool, value T) Result[T] {
+	return r.Box().RecoverIf(match, value)
+}
+
+func (r ResultValue[T]) AsError() error {
+	return r.err
+}
+
+func (r ResultValue[T]) WithMeta(key string, value any) Result[T] {
+	r.meta = &metaEntry{key: key, value: value, next: r.meta}
+	return r
+}
+
+func (r ResultValue[T]) Meta(key string) (any, bool) {
+	return r.meta.lookup(key)
+}
+
+func (r ResultValue[T]) IsErrTimeout() bool {
+	return r.IsErr() && isTimeout(r.err)
+}
+
+func (r ResultValue[T]) IsErrCanceled() bool {
+	return r.IsErr() && isCanceled(r.err)
+}
+
+func (r ResultValue[T]) Value() (T, bool) {
+	return r.t, r.err == nil
+}
+
+func (r ResultValue[T]) WithDefault(def T) Result[T] {
+	return r.Box().WithDefault(def)
+}
+
+func (r ResultValue[T]) SetErr(err error) Result[T] {
+	return errMeta[T](err, r.meta)
+}
+
+func (r ResultValue[T]) Replace(v T) Result[T] {
+	return r.Box().Replace(v)
+}
+
+func (r ResultValue[T]) StackTrace() []uintptr {
+	return stackTrace(r.err)
+}
+
+func (r ResultValue[T]) TeeTo(ch chan<- Result[T]) Result[T] {
+	return teeTo[T](r, ch, false)
+}
+
+func (r ResultValue[T]) TeeToBlocking(ch chan<- Result[T]) Result[T] {
+	return teeTo[T](r, ch, true)
+}
+
+func (r ResultValue[T]) Chain(fs ...func(T) Result[T]) Result[T] {
+	return r.Box().Chain(fs...)
+}
+
+func (r ResultValue[T]) EnsureCtx(ctx context.Context) Result[T] {
+	return r.Box().EnsureCtx(ctx)
+}
+
+func (r ResultValue[T]) Explain() string {
+	return r.Box().Explain()
+}
+
+func (r ResultValue[T]) Or(other Result[T]) Result[T] {
+	if r.err != nil {
+		return other
+	}
+	return r
+}
+
+func (r ResultValue[T]) WithFields(fields map[string]any) Result[T] {
+	return r.Box().WithFields(fields)
+}
+
+func (r ResultValue[T]) UnwrapBoth() (T, error, bool) {
+	return r.t, r.err, r.err == nil
+}
+
+func (r ResultValue[T]) Finally(f func()) Result[T] {
+	f()
+	return r
+}
+
+func (r ResultValue[T]) ToChans() (<-chan T, <-chan error) {
+	return toChans(r.t, r.err)
+}
+
+func (r ResultValue[T]) Normalize() Result[T] {
+	return r
+}
+
+func (r ResultValue[T]) Debug(label string) Result[T] {
+	return debug[T](r, label)
+}
@@ -0,0 +1,116 @@
+package cement
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestResultValueOk(t *testing.T) {
+	r := OkValue(1)
+	assert.Equal(t, r.IsOk(), true)
+	assert.Equal(t, r.Ok(), 1)
+	assert.Equal(t, r.UnwrapOr(2), 1)
+	v, err := r.Split()
+	assert.NilError(t, err)
+	assert.Equal(t, v, 1)
+	assert.Equal(t, r.String(), "Ok(1)")
+	assert.Equal(t, fmt.Sprintf("%#v", r), "cement.OkValue[int](1)")
+	assert.Equal(t, r.Map(func(i int) int { return i + 1 }).Ok(), 2)
+}
+
+func TestResultValueErr(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	r := ErrValue[int](sentinel)
+	assert.Equal(t, r.IsErr(), true)
+	assert.Equal(t, r.Err(), sentinel)
+	assert.Equal(t, r.UnwrapOr(2), 2)
+	assert.Equal(t, r.UnwrapOrZero(), 0)
+	assert.Equal(t, r.Is(sentinel), true)
+	assert.Equal(t, r.String(), "Err(sentinel)")
+	assert.Equal(t, r.AsError(), sentinel)
+	assert.Equal(t, r.WrapErr("ctx").Err().Error(), "ctx: sentinel")
+	assert.Equal(t, ErrValue[int]("xxx").Err().Error(), "xxx")
+}
+
+func TestResultValueZero(t *testing.T) {
+	var r ResultValue[string]
+	assert.Equal(t, r.IsOk(), true)
+	assert.Equal(t, r.Ok(), "")
+}
+
+func TestResultValueBoxUnbox(t *testing.T) {
+	boxed := OkValue(1).WithMeta("k", "v").(ResultValue[int]).Box()
+	assert.Equal(t, IsOkType[int](boxed), true)
+	meta, _ := boxed.Meta("k")
+	assert.Equal(t, meta, "v")
+	assert.Equal(t, Unbox(boxed).Ok(), 1)
+
+	boxed = ErrValue[int]("xxx").Box()
+	assert.Equal(t, IsErrType[int](boxed), true)
+	assert.Equal(t, Unbox(boxed).Err().Error(), "xxx")
+	assert.Equal(t, Unbox(Err[int]("yyy").WithMeta("k", "v")).Err().Error(), "yyy")
+
+	assert.Equal(t, Is[int](OkValue(1)), true)
+	assert.Equal(t, IsOkType[int](OkValue(1)), true)
+	assert.Equal(t, IsErrType[int](ErrValue[int]("x")), true)
+}
+
+func TestResultValueWithCombinators(t *testing.T) {
+	var r Result[int] = OkValue(20)
+	assert.Equal(t, AndThen(r, func(i int) Result[int] { return OkValue(i + 1) }).Ok(), 21)
+	assert.DeepEqual(t, Collect([]Result[int]{OkValue(1), Ok(2)}).Ok(), []int{1, 2})
+}
+
+//go:noinline
+func produceInterface(i int) Result[int] {
+	if i < 0 {
+		return Err[int]("negative")
+	}
+	return Ok(i)
+}
+
+//go:noinline
+func produceValue(i int) ResultValue[int] {
+	if i < 0 {
+		return ErrValue[int]("negative")
+	}
+	return OkValue(i)
+}
+
+func TestResultValueOkPathAllocs(t *testing.T) {
+	sum := 0
+	allocs := testing.AllocsPerRun(100, func() {
+		r := produceValue(1)
+		if r.IsOk() {
+			sum += r.Ok() + r.UnwrapOr(0)
+		}
+	})
+	assert.Equal(t, allocs, 0.0)
+}
+
+func BenchmarkResultInterfaceOk(b *testing.B) {
+	b.ReportAllocs()
+	sum := 0
+	for i := 0; i < b.N; i++ {
+		r := produceInterface(i)
+		if r.IsOk() {
+			sum += r.Ok()
+		}
+	}
+	_ = sum
+}
+
+func BenchmarkResultValueOk(b *testing.B) {
+	b.ReportAllocs()
+	sum := 0
+	for i := 0; i < b.N; i++ {
+		r := produceValue(i)
+		if r.IsOk() {
+			sum += r.Ok()
+		}
+	}
+	_ = sum
+}